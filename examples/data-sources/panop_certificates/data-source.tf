@@ -0,0 +1,15 @@
+check "certificates" {
+  data "panop_certificates" "shop" {
+    zone_id = panop_zone.shop.id
+  }
+
+  assert {
+    condition     = alltrue([for certificate in data.panop_certificates.shop.certificates : certificate.days_remaining >= 14])
+    error_message = "Certificates expire within 14 days: ${join(", ", [for certificate in data.panop_certificates.shop.certificates : "${certificate.asset_name}:${certificate.port}" if certificate.days_remaining < 14])}."
+  }
+
+  assert {
+    condition     = alltrue([for certificate in data.panop_certificates.shop.certificates : length(certificate.validation_errors) == 0])
+    error_message = "Certificates are misconfigured: ${join(", ", [for certificate in data.panop_certificates.shop.certificates : "${certificate.asset_name}:${certificate.port} (${join(", ", certificate.validation_errors)})" if length(certificate.validation_errors) > 0])}."
+  }
+}
@@ -0,0 +1,20 @@
+# Adopt the assets Tower found in the certificate transparency logs
+data "panop_discovered_assets" "shop" {
+  zone_id         = panop_zone.shop.id
+  source          = "certificate_transparency"
+  include_adopted = true
+}
+
+import {
+  for_each = data.panop_discovered_assets.shop.assets
+  to       = panop_asset.adopted[each.key]
+  id       = each.value.id
+}
+
+resource "panop_asset" "adopted" {
+  for_each = data.panop_discovered_assets.shop.assets
+
+  asset_name = each.key
+  asset_type = each.value.asset_type
+  zone_id    = panop_zone.shop.id
+}
@@ -0,0 +1,12 @@
+check "zone_posture" {
+  data "panop_posture" "shop" {
+    zone_id            = panop_zone.shop.id
+    severity_threshold = "critical"
+    max_age_days       = 7
+  }
+
+  assert {
+    condition     = data.panop_posture.shop.pass
+    error_message = "${data.panop_posture.shop.failing_count} critical findings are open for more than 7 days."
+  }
+}
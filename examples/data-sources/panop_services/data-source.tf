@@ -0,0 +1,16 @@
+# Fail when the shop listens on a TCP port the firewall is not meant to open
+locals {
+  allowed_ports = [80, 443]
+}
+
+data "panop_services" "shop" {
+  zone_id  = panop_zone.shop.id
+  protocol = "tcp"
+}
+
+check "open_ports" {
+  assert {
+    condition     = alltrue([for service in data.panop_services.shop.services : contains(local.allowed_ports, service.port)])
+    error_message = "Unexpected open ports: ${join(", ", distinct([for service in data.panop_services.shop.services : "${service.asset_name}:${service.port}" if !contains(local.allowed_ports, service.port)]))}."
+  }
+}
@@ -0,0 +1,8 @@
+list "panop_asset" "www" {
+  provider = panop
+
+  config {
+    zone_id    = 416
+    asset_name = "www"
+  }
+}
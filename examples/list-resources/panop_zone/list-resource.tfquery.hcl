@@ -0,0 +1,7 @@
+list "panop_zone" "dns" {
+  provider = panop
+
+  config {
+    zone_type = "dns"
+  }
+}
@@ -0,0 +1,7 @@
+import {
+  to = panop_asset.example
+  identity = {
+    zone_id    = 416
+    asset_name = "www"
+  }
+}
@@ -0,0 +1,5 @@
+# Import by asset id
+terraform import panop_asset.example 1234
+
+# Import by zone name and asset name
+terraform import panop_asset.example example.com/www
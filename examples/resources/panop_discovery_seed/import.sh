@@ -0,0 +1,2 @@
+# Import by discovery seed id
+terraform import panop_discovery_seed.example 1234
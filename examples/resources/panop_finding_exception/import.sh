@@ -0,0 +1,2 @@
+# Import by finding exception id
+terraform import panop_finding_exception.example 1234
@@ -0,0 +1,2 @@
+# Import by ip range id
+terraform import panop_ip_range.example 1234
@@ -0,0 +1,2 @@
+# Import by scan id
+terraform import panop_scan.example 1234
@@ -0,0 +1,2 @@
+# Import by scan policy id
+terraform import panop_scan_policy.example 1234
@@ -0,0 +1,2 @@
+# Import by scope exclusion id
+terraform import panop_scope_exclusion.example 1234
@@ -0,0 +1,6 @@
+import {
+  to = panop_zone.example
+  identity = {
+    zone_name = "example.com"
+  }
+}
@@ -0,0 +1,5 @@
+# Import by zone id
+terraform import panop_zone.example 416
+
+# Import by zone name
+terraform import panop_zone.example example.com
@@ -0,0 +1,272 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// parseAssetURL parses the name of a url asset: an absolute http or https
+// URL with a host and without credentials.
+func parseAssetURL(name string) (*url.URL, error) {
+	u, err := url.Parse(name)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("the scheme must be http or https")
+	}
+	if u.Hostname() == "" {
+		return nil, fmt.Errorf("the host is empty")
+	}
+	if u.User != nil {
+		return nil, fmt.Errorf("credentials are not allowed")
+	}
+	return u, nil
+}
+
+// normalizeURL returns the canonical form of a url asset name: scheme and
+// host normalized, default port and fragment removed and an empty path
+// replaced by /. Names that cannot be parsed are returned unchanged.
+func normalizeURL(name string) string {
+	u, err := parseAssetURL(name)
+	if err != nil {
+		return name
+	}
+
+	host := normalizeDomainName(u.Hostname())
+	if port := u.Port(); port != "" && !(u.Scheme == "http" && port == "80") && !(u.Scheme == "https" && port == "443") {
+		host = net.JoinHostPort(host, port)
+	} else if strings.Contains(host, ":") {
+		host = "[" + host + "]"
+	}
+
+	normalized := url.URL{
+		Scheme:   u.Scheme,
+		Host:     host,
+		Path:     u.Path,
+		RawPath:  u.RawPath,
+		RawQuery: u.RawQuery,
+	}
+	if normalized.Path == "" {
+		normalized.Path = "/"
+	}
+	return normalized.String()
+}
+
+// normalizeAddress returns the canonical form of an IP address or CIDR
+// block asset name, ok is false when name is neither. CIDR blocks are
+// masked and a single address block is written as the bare address, so
+// 10.0.0.1/24 and 10.0.0.0/24 or 2001:db8:0::1 and 2001:db8::1/128 are
+// the same name.
+func normalizeAddress(name string) (normalized string, ok bool) {
+	if addr, err := netip.ParseAddr(name); err == nil {
+		return addr.String(), true
+	}
+	if !strings.Contains(name, "/") {
+		return "", false
+	}
+	prefix, err := parseCIDR(name)
+	if err != nil {
+		return "", false
+	}
+	if prefix.IsSingleIP() {
+		return prefix.Addr().String(), true
+	}
+	return prefix.String(), true
+}
+
+// normalizeAssetName returns the canonical form of an asset name. URLs are
+// normalized with normalizeURL, addresses and CIDR blocks with
+// normalizeAddress and other names, including wildcards, with
+// normalizeDomainName.
+func normalizeAssetName(name string) string {
+	if strings.Contains(name, "://") {
+		return normalizeURL(name)
+	}
+	if normalized, ok := normalizeAddress(name); ok {
+		return normalized
+	}
+	return normalizeDomainName(name)
+}
+
+// canonicalAssetName returns the form of an asset name sent to Tower:
+// wildcards and URLs normalized, addresses in their shortest form and CIDR
+// blocks masked, as panop_ip_range sends them. DNS names are sent as
+// configured. Names that cannot be parsed are returned unchanged.
+func canonicalAssetName(assetType, name string) string {
+	switch assetType {
+	case assetTypeWildcard, assetTypeURL:
+		return normalizeAssetName(name)
+	case assetTypeIP:
+		if addr, err := netip.ParseAddr(name); err == nil {
+			return addr.String()
+		}
+	case assetTypeCIDR:
+		if prefix, err := parseCIDR(name); err == nil {
+			return prefix.String()
+		}
+	}
+	return name
+}
+
+// assetHostname returns the host name an asset is served on: the name of
+// dns assets, the parent domain of wildcard assets and the host of url
+// assets. It is empty for other asset types.
+func assetHostname(assetType, name string) string {
+	switch assetType {
+	case assetTypeDNS:
+		return name
+	case assetTypeWildcard:
+		return strings.TrimPrefix(name, "*.")
+	case assetTypeURL:
+		if u, err := parseAssetURL(name); err == nil {
+			return u.Hostname()
+		}
+	}
+	return ""
+}
+
+// withAssetDetails fills the host details of asset that Tower did not
+// return from the asset name.
+func withAssetDetails(asset assetResponse) assetResponse {
+	if asset.Hostname == "" {
+		asset.Hostname = assetHostname(asset.AssetType, asset.AssetName)
+	}
+	if asset.AssetType != assetTypeURL {
+		return asset
+	}
+
+	u, err := parseAssetURL(asset.AssetName)
+	if err != nil {
+		return asset
+	}
+	if asset.Scheme == "" {
+		asset.Scheme = u.Scheme
+	}
+	if asset.Port == 0 {
+		switch port, _ := strconv.ParseInt(u.Port(), 10, 64); {
+		case port != 0:
+			asset.Port = port
+		case u.Scheme == "http":
+			asset.Port = 80
+		default:
+			asset.Port = 443
+		}
+	}
+	if asset.Path == "" {
+		asset.Path = u.EscapedPath()
+		if asset.Path == "" {
+			asset.Path = "/"
+		}
+	}
+	return asset
+}
+
+var _ basetypes.StringTypable = AssetNameType{}
+
+// AssetNameType is a string type for asset names, its values are
+// semantically equal when they designate the same asset, see
+// normalizeAssetName.
+type AssetNameType struct {
+	basetypes.StringType
+}
+
+func (t AssetNameType) String() string {
+	return "AssetNameType"
+}
+
+func (t AssetNameType) ValueType(ctx context.Context) attr.Value {
+	return AssetNameValue{}
+}
+
+func (t AssetNameType) Equal(o attr.Type) bool {
+	other, ok := o.(AssetNameType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t AssetNameType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return AssetNameValue{StringValue: in}, nil
+}
+
+func (t AssetNameType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+var _ basetypes.StringValuableWithSemanticEquals = AssetNameValue{}
+
+// AssetNameValue is a value of AssetNameType.
+type AssetNameValue struct {
+	basetypes.StringValue
+}
+
+// NewAssetNameValue returns a known AssetNameValue holding name.
+func NewAssetNameValue(name string) AssetNameValue {
+	return AssetNameValue{StringValue: basetypes.NewStringValue(name)}
+}
+
+func (v AssetNameValue) Type(ctx context.Context) attr.Type {
+	return AssetNameType{}
+}
+
+func (v AssetNameValue) Equal(o attr.Value) bool {
+	other, ok := o.(AssetNameValue)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals reports whether both names designate the same
+// asset, e.g. Shop.Example.com. and shop.example.com or
+// HTTPS://Shop.Example.com:443 and https://shop.example.com/.
+func (v AssetNameValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(AssetNameValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	return normalizeAssetName(v.ValueString()) == normalizeAssetName(newValue.ValueString()), diags
+}
@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestNormalizeAssetName(t *testing.T) {
+	for _, tc := range []struct {
+		in, want string
+	}{
+		{"WWW.Example.com.", "www.example.com"},
+		{"*.Apps.Example.com", "*.apps.example.com"},
+		{"HTTPS://Shop.Example.com", "https://shop.example.com/"},
+		{"https://shop.example.com:443/admin#top", "https://shop.example.com/admin"},
+		{"http://shop.example.com:80/", "http://shop.example.com/"},
+		{"http://shop.example.com:8080/Admin?Tab=1", "http://shop.example.com:8080/Admin?Tab=1"},
+		{"https://bücher.example/", "https://xn--bcher-kva.example/"},
+		{"https://[2001:DB8::1]:8443/", "https://[2001:db8::1]:8443/"},
+		{"https://[2001:db8::1]:443", "https://[2001:db8::1]/"},
+		{"2001:DB8:0::1", "2001:db8::1"},
+		{"2001:db8:0:0::1/128", "2001:db8::1"},
+		{"10.0.0.1/24", "10.0.0.0/24"},
+		{"2001:db8:0::1/32", "2001:db8::/32"},
+		{"192.0.2.1/32", "192.0.2.1"},
+	} {
+		if got := normalizeAssetName(tc.in); got != tc.want {
+			t.Errorf("normalizeAssetName(%q) = %q, want %q", tc.in, got, tc.want)
+		}
+	}
+}
+
+func TestAssetNameValueSemanticEquals(t *testing.T) {
+	for _, tc := range []struct {
+		prior, current string
+		want           bool
+	}{
+		{"Shop.Example.com.", "shop.example.com", true},
+		{"HTTPS://Shop.Example.com:443", "https://shop.example.com/", true},
+		{"https://shop.example.com/admin", "https://shop.example.com/Admin", false},
+		{"https://shop.example.com/", "http://shop.example.com/", false},
+		{"*.apps.example.com", "apps.example.com", false},
+		{"10.0.0.1/24", "10.0.0.0/24", true},
+		{"2001:db8:0::1", "2001:db8::1", true},
+		{"10.0.0.0/24", "10.0.0.0/25", false},
+	} {
+		equal, diags := NewAssetNameValue(tc.prior).StringSemanticEquals(context.Background(), NewAssetNameValue(tc.current))
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		if equal != tc.want {
+			t.Errorf("StringSemanticEquals(%q, %q) = %t, want %t", tc.prior, tc.current, equal, tc.want)
+		}
+	}
+}
+
+func TestCanonicalAssetName(t *testing.T) {
+	for _, tc := range []struct {
+		assetType, in, want string
+	}{
+		{"dns", "WWW.Example.com", "WWW.Example.com"},
+		{"wildcard", "*.Apps.Example.com", "*.apps.example.com"},
+		{"ip", "2001:DB8:0::1", "2001:db8::1"},
+		{"cidr", "10.0.0.1/24", "10.0.0.0/24"},
+		{"cidr", "2001:db8:0::1/32", "2001:db8::/32"},
+		{"cidr", "192.0.2.1", "192.0.2.1/32"},
+	} {
+		if got := canonicalAssetName(tc.assetType, tc.in); got != tc.want {
+			t.Errorf("canonicalAssetName(%q, %q) = %q, want %q", tc.assetType, tc.in, got, tc.want)
+		}
+	}
+}
+
+func TestWithAssetDetails(t *testing.T) {
+	for _, tc := range []struct {
+		in   assetResponse
+		want assetResponse
+	}{
+		{
+			in:   assetResponse{AssetName: "www.example.com", AssetType: "dns"},
+			want: assetResponse{AssetName: "www.example.com", AssetType: "dns", Hostname: "www.example.com"},
+		},
+		{
+			in:   assetResponse{AssetName: "*.apps.example.com", AssetType: "wildcard"},
+			want: assetResponse{AssetName: "*.apps.example.com", AssetType: "wildcard", Hostname: "apps.example.com"},
+		},
+		{
+			in:   assetResponse{AssetName: "192.0.2.0/24", AssetType: "cidr"},
+			want: assetResponse{AssetName: "192.0.2.0/24", AssetType: "cidr"},
+		},
+		{
+			in: assetResponse{AssetName: "https://shop.example.com", AssetType: "url"},
+			want: assetResponse{AssetName: "https://shop.example.com", AssetType: "url",
+				Hostname: "shop.example.com", Scheme: "https", Port: 443, Path: "/"},
+		},
+		{
+			in: assetResponse{AssetName: "http://shop.example.com:8080/admin", AssetType: "url"},
+			want: assetResponse{AssetName: "http://shop.example.com:8080/admin", AssetType: "url",
+				Hostname: "shop.example.com", Scheme: "http", Port: 8080, Path: "/admin"},
+		},
+		{
+			// Values returned by Tower are kept.
+			in: assetResponse{AssetName: "https://shop.example.com/admin", AssetType: "url", Hostname: "lb.example.net", Port: 8443},
+			want: assetResponse{AssetName: "https://shop.example.com/admin", AssetType: "url",
+				Hostname: "lb.example.net", Scheme: "https", Port: 8443, Path: "/admin"},
+		},
+	} {
+		if got := withAssetDetails(tc.in); !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("withAssetDetails(%+v) = %+v, want %+v", tc.in, got, tc.want)
+		}
+	}
+}
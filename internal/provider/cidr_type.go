@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// parseCIDR parses an IPv4 or IPv6 CIDR block. A bare address is read as a
+// single address block (/32 or /128). The returned prefix is masked, so
+// 10.0.0.1/24 and 10.0.0.0/24 give the same block.
+func parseCIDR(s string) (netip.Prefix, error) {
+	if !strings.Contains(s, "/") {
+		addr, err := netip.ParseAddr(s)
+		if err != nil {
+			return netip.Prefix{}, err
+		}
+		return netip.PrefixFrom(addr, addr.BitLen()), nil
+	}
+
+	prefix, err := netip.ParsePrefix(s)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	return prefix.Masked(), nil
+}
+
+var _ basetypes.StringTypable = CIDRType{}
+
+// CIDRType is a string type for IPv4 and IPv6 CIDR blocks, its values are
+// semantically equal when they designate the same block.
+type CIDRType struct {
+	basetypes.StringType
+}
+
+func (t CIDRType) String() string {
+	return "CIDRType"
+}
+
+func (t CIDRType) ValueType(ctx context.Context) attr.Value {
+	return CIDRValue{}
+}
+
+func (t CIDRType) Equal(o attr.Type) bool {
+	other, ok := o.(CIDRType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t CIDRType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return CIDRValue{StringValue: in}, nil
+}
+
+func (t CIDRType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+var _ basetypes.StringValuableWithSemanticEquals = CIDRValue{}
+var _ xattr.ValidateableAttribute = CIDRValue{}
+
+// CIDRValue is a value of CIDRType.
+type CIDRValue struct {
+	basetypes.StringValue
+}
+
+// NewCIDRValue returns a known CIDRValue holding cidr.
+func NewCIDRValue(cidr string) CIDRValue {
+	return CIDRValue{StringValue: basetypes.NewStringValue(cidr)}
+}
+
+func (v CIDRValue) Type(ctx context.Context) attr.Type {
+	return CIDRType{}
+}
+
+func (v CIDRValue) Equal(o attr.Value) bool {
+	other, ok := o.(CIDRValue)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals reports whether both values designate the same
+// block, e.g. 2001:DB8:0::/32 and 2001:db8::/32.
+func (v CIDRValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(CIDRValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	prior, err := parseCIDR(v.ValueString())
+	if err != nil {
+		return false, diags
+	}
+	current, err := parseCIDR(newValue.ValueString())
+	if err != nil {
+		return false, diags
+	}
+
+	return prior == current, diags
+}
+
+// ValidateAttribute checks the value is an IPv4 or IPv6 CIDR block.
+func (v CIDRValue) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	if _, err := parseCIDR(v.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid CIDR Block",
+			fmt.Sprintf("%q is not a valid IPv4 or IPv6 CIDR block: %s.", v.ValueString(), err),
+		)
+	}
+}
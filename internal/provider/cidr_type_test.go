@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+)
+
+func TestParseCIDR(t *testing.T) {
+	for _, tc := range []struct {
+		in, want string
+		valid    bool
+	}{
+		{"192.0.2.0/24", "192.0.2.0/24", true},
+		{"192.0.2.17/24", "192.0.2.0/24", true},
+		{"192.0.2.1", "192.0.2.1/32", true},
+		{"2001:DB8::/32", "2001:db8::/32", true},
+		{"2001:db8::1", "2001:db8::1/128", true},
+		{"192.0.2.0/33", "", false},
+		{"example.com", "", false},
+		{"", "", false},
+	} {
+		prefix, err := parseCIDR(tc.in)
+		if !tc.valid {
+			if err == nil {
+				t.Errorf("parseCIDR(%q) expected an error", tc.in)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("parseCIDR(%q) returned unexpected error: %s", tc.in, err)
+			continue
+		}
+		if got := prefix.String(); got != tc.want {
+			t.Errorf("parseCIDR(%q) = %s, want %s", tc.in, got, tc.want)
+		}
+	}
+}
+
+func TestCIDRValueSemanticEquals(t *testing.T) {
+	for _, tc := range []struct {
+		prior, current string
+		want           bool
+	}{
+		{"192.0.2.0/24", "192.0.2.0/24", true},
+		{"192.0.2.17/24", "192.0.2.0/24", true},
+		{"192.0.2.1", "192.0.2.1/32", true},
+		{"2001:DB8:0::/32", "2001:db8::/32", true},
+		{"192.0.2.0/24", "192.0.2.0/25", false},
+		{"192.0.2.0/24", "not-a-cidr", false},
+	} {
+		equal, diags := NewCIDRValue(tc.prior).StringSemanticEquals(context.Background(), NewCIDRValue(tc.current))
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		if equal != tc.want {
+			t.Errorf("StringSemanticEquals(%q, %q) = %t, want %t", tc.prior, tc.current, equal, tc.want)
+		}
+	}
+}
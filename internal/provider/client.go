@@ -0,0 +1,665 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// errNotFound is returned, wrapped, by the Tower calls when the requested
+// object does not exist.
+var errNotFound = errors.New("not found")
+
+// clientObj carries the Tower connection settings handed to resources and
+// data sources by the provider Configure method.
+type clientObj struct {
+	clientHttp *http.Client
+	host       string
+	accessKey  string
+
+	// defaultTags are the provider default tags, merged into the tags of
+	// every taggable resource.
+	defaultTags map[string]string
+}
+
+// newClientObj builds the Tower client used by the provider and the export
+// command.
+func newClientObj(host, accessKey string, skipTLSVerify bool) clientObj {
+	return clientObj{
+		clientHttp: &http.Client{
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{InsecureSkipVerify: skipTLSVerify},
+			},
+		},
+		host:      host,
+		accessKey: accessKey,
+	}
+}
+
+// zoneResponse is a zone as returned by GET /api/zones.
+type zoneResponse struct {
+	Id        int64             `json:"id"`
+	ZoneName  string            `json:"zone_name"`
+	ZoneType  string            `json:"zone_type"`
+	Validated bool              `json:"validated"`
+	Token     string            `json:"token"`
+	TenantId  int64             `json:"tenant_id"`
+	Tags      map[string]string `json:"tags"`
+
+	Criticality  string `json:"criticality"`
+	OwnerEmail   string `json:"owner_email"`
+	BusinessUnit string `json:"business_unit"`
+	Description  string `json:"description"`
+
+	// PolicyId is the scan policy of the zone, 0 for the Tower default.
+	PolicyId int64 `json:"policy_id"`
+
+	// AutoDiscovery is nil when the Tower default discovery settings apply.
+	AutoDiscovery *zoneAutoDiscovery `json:"auto_discovery"`
+}
+
+// zoneAutoDiscovery holds how Tower discovers the subdomains and assets of
+// a zone. No sources means every source.
+type zoneAutoDiscovery struct {
+	Enabled bool     `json:"enabled"`
+	Depth   int64    `json:"depth"`
+	Sources []string `json:"sources"`
+}
+
+// zoneUpdateInput is the body of PATCH /api/zones/{id}. It replaces the
+// tags, business metadata, scan policy and discovery settings of the zone,
+// empty strings clear them, a 0 policy id restores the Tower default policy
+// and a null auto_discovery the Tower default discovery settings.
+type zoneUpdateInput struct {
+	Tags          map[string]string  `json:"tags"`
+	Criticality   string             `json:"criticality"`
+	OwnerEmail    string             `json:"owner_email"`
+	BusinessUnit  string             `json:"business_unit"`
+	Description   string             `json:"description"`
+	PolicyId      int64              `json:"policy_id"`
+	AutoDiscovery *zoneAutoDiscovery `json:"auto_discovery"`
+}
+
+// assetResponse is an asset as returned by GET /api/assets. Hostname is
+// set for dns, wildcard and url assets, Scheme, Port and Path only for url
+// assets.
+type assetResponse struct {
+	AssetId   int64             `json:"id"`
+	AssetName string            `json:"asset_name"`
+	AssetType string            `json:"asset_type"`
+	ZoneId    int64             `json:"zone_id"`
+	Hostname  string            `json:"hostname"`
+	Scheme    string            `json:"scheme"`
+	Port      int64             `json:"port"`
+	Path      string            `json:"path"`
+	Tags      map[string]string `json:"tags"`
+
+	Criticality  string `json:"criticality"`
+	OwnerEmail   string `json:"owner_email"`
+	BusinessUnit string `json:"business_unit"`
+	Description  string `json:"description"`
+
+	// Observation of the asset by Tower.
+	Status          string   `json:"status"`
+	FirstSeen       string   `json:"first_seen"`
+	LastSeen        string   `json:"last_seen"`
+	ResolvedIPs     []string `json:"resolved_ips"`
+	DiscoverySource string   `json:"discovery_source"`
+	MonitoringState string   `json:"monitoring_state"`
+	PausedUntil     string   `json:"paused_until"`
+
+	// DiscoveredBy is the discovery source that found a discovered asset,
+	// such as passive_dns.
+	DiscoveredBy string `json:"discovered_by"`
+}
+
+// monitoringStatePaused is the monitoring state of an asset whose
+// monitoring is paused.
+const monitoringStatePaused = "paused"
+
+// discoverySourceDiscovered is the discovery source of an asset Tower found
+// on its own rather than one registered through the API.
+const discoverySourceDiscovered = "discovered"
+
+// discoverySourceAdopted is the discovery source of a discovered asset once
+// adopted, see adoptAsset.
+const discoverySourceAdopted = "adopted"
+
+// assetInput is the body of POST /api/assets.
+type assetInput struct {
+	AssetName string            `json:"asset_name"`
+	AssetType string            `json:"asset_type"`
+	ZoneId    int64             `json:"zone_id"`
+	Tags      map[string]string `json:"tags,omitempty"`
+
+	Criticality  string `json:"criticality,omitempty"`
+	OwnerEmail   string `json:"owner_email,omitempty"`
+	BusinessUnit string `json:"business_unit,omitempty"`
+	Description  string `json:"description,omitempty"`
+}
+
+// assetUpdateInput is the body of PATCH /api/assets/{id}. It replaces the
+// tags and business metadata of the asset, empty strings clear them.
+type assetUpdateInput struct {
+	Tags         map[string]string `json:"tags"`
+	Criticality  string            `json:"criticality"`
+	OwnerEmail   string            `json:"owner_email"`
+	BusinessUnit string            `json:"business_unit"`
+	Description  string            `json:"description"`
+}
+
+// assetPauseInput is the body of POST /api/assets/{id}/pause. Tower resumes
+// the monitoring of the asset at Until, when set.
+type assetPauseInput struct {
+	Until string `json:"until,omitempty"`
+}
+
+// assetCreateResponse is the body returned by POST /api/assets.
+type assetCreateResponse struct {
+	AssetId   int64  `json:"asset_id"`
+	AssetName string `json:"asset_name"`
+	AssetType string `json:"asset_type"`
+	Hostname  string `json:"hostname"`
+	Scheme    string `json:"scheme"`
+	Port      int64  `json:"port"`
+	Path      string `json:"path"`
+}
+
+// Scan statuses reported by Tower. Scans are queued, then running, and end
+// up completed, failed or cancelled.
+const (
+	scanStatusQueued    = "queued"
+	scanStatusRunning   = "running"
+	scanStatusCompleted = "completed"
+	scanStatusFailed    = "failed"
+	scanStatusCancelled = "cancelled"
+)
+
+// scanInput is the body of POST /api/scans.
+type scanInput struct {
+	ZoneIds  []int64 `json:"zone_ids,omitempty"`
+	AssetIds []int64 `json:"asset_ids,omitempty"`
+}
+
+// scanSummary counts the findings of a scan by severity.
+type scanSummary struct {
+	Critical int64 `json:"critical"`
+	High     int64 `json:"high"`
+	Medium   int64 `json:"medium"`
+	Low      int64 `json:"low"`
+	Info     int64 `json:"info"`
+}
+
+// scanResponse is a scan as returned by POST /api/scans and
+// GET /api/scans/{id}.
+type scanResponse struct {
+	Id         int64       `json:"id"`
+	Status     string      `json:"status"`
+	ZoneIds    []int64     `json:"zone_ids"`
+	AssetIds   []int64     `json:"asset_ids"`
+	StartedAt  string      `json:"started_at"`
+	FinishedAt string      `json:"finished_at"`
+	Summary    scanSummary `json:"summary"`
+}
+
+// scanBlackoutWindow is a weekly window during which Tower does not scan,
+// Start and End are times of day such as 22:30 in Timezone.
+type scanBlackoutWindow struct {
+	Days     []string `json:"days"`
+	Start    string   `json:"start"`
+	End      string   `json:"end"`
+	Timezone string   `json:"timezone"`
+}
+
+// scanPolicyInput is the body of POST /api/scan-policies and
+// PUT /api/scan-policies/{id}. Tower enables every module and scans its
+// default ports when Modules and PortRanges are empty.
+type scanPolicyInput struct {
+	Name            string               `json:"name"`
+	Frequency       string               `json:"frequency"`
+	Modules         []string             `json:"modules,omitempty"`
+	PortRanges      []string             `json:"port_ranges,omitempty"`
+	Intensity       string               `json:"intensity"`
+	BlackoutWindows []scanBlackoutWindow `json:"blackout_windows"`
+}
+
+// scanPolicyResponse is a scan policy as returned by Tower.
+type scanPolicyResponse struct {
+	Id int64 `json:"id"`
+	scanPolicyInput
+}
+
+// findingResponse is a finding as returned by GET /api/findings. RuleId
+// identifies the check that raised the finding, the same rule raises a
+// finding on every asset it applies to.
+type findingResponse struct {
+	Id        int64   `json:"id"`
+	Title     string  `json:"title"`
+	Severity  string  `json:"severity"`
+	Cvss      float64 `json:"cvss"`
+	Status    string  `json:"status"`
+	RuleId    string  `json:"rule_id"`
+	AssetId   int64   `json:"asset_id"`
+	AssetName string  `json:"asset_name"`
+	ZoneId    int64   `json:"zone_id"`
+	FirstSeen string  `json:"first_seen"`
+	LastSeen  string  `json:"last_seen"`
+}
+
+// serviceResponse is a network service as returned by GET /api/services,
+// an open port of an asset. Product, Version and Banner are empty when
+// Tower could not fingerprint the service.
+type serviceResponse struct {
+	AssetId   int64  `json:"asset_id"`
+	AssetName string `json:"asset_name"`
+	ZoneId    int64  `json:"zone_id"`
+	Port      int64  `json:"port"`
+	Protocol  string `json:"protocol"`
+	Service   string `json:"service"`
+	Product   string `json:"product"`
+	Version   string `json:"version"`
+	Banner    string `json:"banner"`
+	FirstSeen string `json:"first_seen"`
+	LastSeen  string `json:"last_seen"`
+}
+
+// certificateResponse is a TLS certificate as returned by
+// GET /api/certificates, the leaf certificate an asset served on Port.
+// ValidationErrors lists why clients would reject it, such as expired or
+// hostname_mismatch.
+type certificateResponse struct {
+	AssetId           int64    `json:"asset_id"`
+	AssetName         string   `json:"asset_name"`
+	ZoneId            int64    `json:"zone_id"`
+	Port              int64    `json:"port"`
+	Subject           string   `json:"subject"`
+	Sans              []string `json:"sans"`
+	Issuer            string   `json:"issuer"`
+	SerialNumber      string   `json:"serial_number"`
+	FingerprintSha256 string   `json:"fingerprint_sha256"`
+	NotBefore         string   `json:"not_before"`
+	NotAfter          string   `json:"not_after"`
+	KeyType           string   `json:"key_type"`
+	KeySize           int64    `json:"key_size"`
+	ValidationErrors  []string `json:"validation_errors"`
+}
+
+// findingExceptionInput is the body of POST /api/finding-exceptions. The
+// exception applies either to FindingId, or to the findings RuleId raises
+// on AssetId.
+type findingExceptionInput struct {
+	FindingId     int64  `json:"finding_id,omitempty"`
+	RuleId        string `json:"rule_id,omitempty"`
+	AssetId       int64  `json:"asset_id,omitempty"`
+	Status        string `json:"status"`
+	Justification string `json:"justification"`
+	ExpiresAt     string `json:"expires_at,omitempty"`
+}
+
+// findingExceptionUpdateInput is the body of
+// PATCH /api/finding-exceptions/{id}, an empty ExpiresAt removes the
+// expiry.
+type findingExceptionUpdateInput struct {
+	Status        string `json:"status"`
+	Justification string `json:"justification"`
+	ExpiresAt     string `json:"expires_at"`
+}
+
+// findingExceptionResponse is a finding exception as returned by Tower.
+// Active is false once the exception expired, Tower then reverted the
+// findings to open.
+type findingExceptionResponse struct {
+	Id int64 `json:"id"`
+	findingExceptionInput
+	Active bool `json:"active"`
+}
+
+// scopeExclusionInput is the body of POST /api/scope-exclusions and
+// PUT /api/scope-exclusions/{id}.
+type scopeExclusionInput struct {
+	ZoneId int64    `json:"zone_id"`
+	Hosts  []string `json:"hosts"`
+	Cidrs  []string `json:"cidrs"`
+	Ports  []string `json:"ports"`
+	Paths  []string `json:"paths"`
+	Reason string   `json:"reason"`
+}
+
+// scopeExclusionResponse is a scope exclusion as returned by Tower.
+type scopeExclusionResponse struct {
+	Id int64 `json:"id"`
+	scopeExclusionInput
+}
+
+// Discovery seed types supported by Tower.
+const (
+	discoverySeedOrganization = "organization"
+	discoverySeedASN          = "asn"
+	discoverySeedKeyword      = "keyword"
+)
+
+// discoverySeedInput is the body of POST /api/discovery-seeds.
+type discoverySeedInput struct {
+	ZoneId   int64  `json:"zone_id"`
+	SeedType string `json:"seed_type"`
+	Value    string `json:"value"`
+}
+
+// discoverySeedResponse is a discovery seed as returned by Tower.
+type discoverySeedResponse struct {
+	Id int64 `json:"id"`
+	discoverySeedInput
+}
+
+// sendJSON performs an authenticated request on the Tower API with in, when
+// not nil, as JSON body. It fails unless the response status is
+// expectedStatus, with errNotFound on 404, and decodes the JSON response body
+// into out, when not nil.
+func (c clientObj) sendJSON(ctx context.Context, method, path string, in any, out any, expectedStatus int) error {
+	urlSvc := url.URL{
+		Scheme: "https",
+		Host:   c.host,
+		Path:   path,
+	}
+
+	var body io.Reader
+	if in != nil {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(b)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, method, urlSvc.String(), body)
+	if err != nil {
+		return err
+	}
+
+	httpReq.Header.Add("Authorization", fmt.Sprintf("Bearer %s", c.accessKey))
+	httpReq.Header.Add("Content-Type", "application/json")
+
+	httpResp, err := c.clientHttp.Do(httpReq)
+	if err != nil {
+		return err
+	}
+	defer httpResp.Body.Close()
+
+	respBody, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return err
+	}
+
+	if httpResp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("%s %s: %w", method, path, errNotFound)
+	}
+	if httpResp.StatusCode != expectedStatus {
+		return fmt.Errorf("%s %s: %s", method, path, httpResp.Status)
+	}
+
+	if out == nil {
+		return nil
+	}
+	return json.Unmarshal(respBody, out)
+}
+
+// getJSON performs an authenticated GET on the Tower API and decodes the
+// JSON response body into out.
+func (c clientObj) getJSON(ctx context.Context, path string, out any) error {
+	return c.sendJSON(ctx, http.MethodGet, path, nil, out, http.StatusOK)
+}
+
+// listZones returns every zone of the tenant.
+func (c clientObj) listZones(ctx context.Context) ([]zoneResponse, error) {
+	zones := []zoneResponse{}
+	if err := c.getJSON(ctx, "/api/zones", &zones); err != nil {
+		return nil, err
+	}
+	return zones, nil
+}
+
+// listAssets returns every asset of the tenant.
+func (c clientObj) listAssets(ctx context.Context) ([]assetResponse, error) {
+	assets := []assetResponse{}
+	if err := c.getJSON(ctx, "/api/assets", &assets); err != nil {
+		return nil, err
+	}
+	return assets, nil
+}
+
+// findAsset returns the asset id, found is false when Tower does not know
+// it.
+func (c clientObj) findAsset(ctx context.Context, id int64) (assetResponse, bool, error) {
+	assets, err := c.listAssets(ctx)
+	if err != nil {
+		return assetResponse{}, false, err
+	}
+	for _, asset := range assets {
+		if asset.AssetId == id {
+			return asset, true, nil
+		}
+	}
+	return assetResponse{}, false, nil
+}
+
+// createAsset creates an asset.
+func (c clientObj) createAsset(ctx context.Context, in assetInput) (assetCreateResponse, error) {
+	asset := assetCreateResponse{}
+	if err := c.sendJSON(ctx, http.MethodPost, "/api/assets", in, &asset, http.StatusCreated); err != nil {
+		return assetCreateResponse{}, err
+	}
+	return asset, nil
+}
+
+// deleteAsset deletes the asset id.
+func (c clientObj) deleteAsset(ctx context.Context, id int64) error {
+	return c.sendJSON(ctx, http.MethodDelete, fmt.Sprintf("/api/assets/%d", id), nil, nil, http.StatusOK)
+}
+
+// updateZone updates the zone id.
+func (c clientObj) updateZone(ctx context.Context, id int64, in zoneUpdateInput) error {
+	return c.sendJSON(ctx, http.MethodPatch, fmt.Sprintf("/api/zones/%d", id), in, nil, http.StatusOK)
+}
+
+// updateAsset updates the asset id.
+func (c clientObj) updateAsset(ctx context.Context, id int64, in assetUpdateInput) error {
+	return c.sendJSON(ctx, http.MethodPatch, fmt.Sprintf("/api/assets/%d", id), in, nil, http.StatusOK)
+}
+
+// pauseAsset pauses the monitoring of the asset id, Tower keeps the asset
+// and its history.
+func (c clientObj) pauseAsset(ctx context.Context, id int64, in assetPauseInput) error {
+	return c.sendJSON(ctx, http.MethodPost, fmt.Sprintf("/api/assets/%d/pause", id), in, nil, http.StatusOK)
+}
+
+// adoptAsset marks the discovered asset id as adopted, Tower then reports
+// it with the adopted discovery source.
+func (c clientObj) adoptAsset(ctx context.Context, id int64) error {
+	return c.sendJSON(ctx, http.MethodPost, fmt.Sprintf("/api/assets/%d/adopt", id), nil, nil, http.StatusOK)
+}
+
+// resumeAsset resumes the monitoring of the asset id.
+func (c clientObj) resumeAsset(ctx context.Context, id int64) error {
+	return c.sendJSON(ctx, http.MethodPost, fmt.Sprintf("/api/assets/%d/resume", id), nil, nil, http.StatusOK)
+}
+
+// createScan starts a scan.
+func (c clientObj) createScan(ctx context.Context, in scanInput) (scanResponse, error) {
+	scan := scanResponse{}
+	if err := c.sendJSON(ctx, http.MethodPost, "/api/scans", in, &scan, http.StatusCreated); err != nil {
+		return scanResponse{}, err
+	}
+	return scan, nil
+}
+
+// getScan returns the scan id.
+func (c clientObj) getScan(ctx context.Context, id int64) (scanResponse, error) {
+	scan := scanResponse{}
+	if err := c.getJSON(ctx, fmt.Sprintf("/api/scans/%d", id), &scan); err != nil {
+		return scanResponse{}, err
+	}
+	return scan, nil
+}
+
+// cancelScan cancels the queued or running scan id.
+func (c clientObj) cancelScan(ctx context.Context, id int64) error {
+	return c.sendJSON(ctx, http.MethodPost, fmt.Sprintf("/api/scans/%d/cancel", id), nil, nil, http.StatusOK)
+}
+
+// createScanPolicy creates a scan policy.
+func (c clientObj) createScanPolicy(ctx context.Context, in scanPolicyInput) (scanPolicyResponse, error) {
+	policy := scanPolicyResponse{}
+	if err := c.sendJSON(ctx, http.MethodPost, "/api/scan-policies", in, &policy, http.StatusCreated); err != nil {
+		return scanPolicyResponse{}, err
+	}
+	return policy, nil
+}
+
+// getScanPolicy returns the scan policy id.
+func (c clientObj) getScanPolicy(ctx context.Context, id int64) (scanPolicyResponse, error) {
+	policy := scanPolicyResponse{}
+	if err := c.getJSON(ctx, fmt.Sprintf("/api/scan-policies/%d", id), &policy); err != nil {
+		return scanPolicyResponse{}, err
+	}
+	return policy, nil
+}
+
+// updateScanPolicy replaces the settings of the scan policy id.
+func (c clientObj) updateScanPolicy(ctx context.Context, id int64, in scanPolicyInput) (scanPolicyResponse, error) {
+	policy := scanPolicyResponse{}
+	if err := c.sendJSON(ctx, http.MethodPut, fmt.Sprintf("/api/scan-policies/%d", id), in, &policy, http.StatusOK); err != nil {
+		return scanPolicyResponse{}, err
+	}
+	return policy, nil
+}
+
+// deleteScanPolicy deletes the scan policy id.
+func (c clientObj) deleteScanPolicy(ctx context.Context, id int64) error {
+	return c.sendJSON(ctx, http.MethodDelete, fmt.Sprintf("/api/scan-policies/%d", id), nil, nil, http.StatusOK)
+}
+
+// listFindings returns every finding of the tenant.
+func (c clientObj) listFindings(ctx context.Context) ([]findingResponse, error) {
+	findings := []findingResponse{}
+	if err := c.getJSON(ctx, "/api/findings", &findings); err != nil {
+		return nil, err
+	}
+	return findings, nil
+}
+
+// listServices returns every network service Tower observed on the assets
+// of the tenant.
+func (c clientObj) listServices(ctx context.Context) ([]serviceResponse, error) {
+	services := []serviceResponse{}
+	if err := c.getJSON(ctx, "/api/services", &services); err != nil {
+		return nil, err
+	}
+	return services, nil
+}
+
+// listCertificates returns every TLS certificate Tower observed on the
+// assets of the tenant.
+func (c clientObj) listCertificates(ctx context.Context) ([]certificateResponse, error) {
+	certificates := []certificateResponse{}
+	if err := c.getJSON(ctx, "/api/certificates", &certificates); err != nil {
+		return nil, err
+	}
+	return certificates, nil
+}
+
+// createFindingException creates a finding exception.
+func (c clientObj) createFindingException(ctx context.Context, in findingExceptionInput) (findingExceptionResponse, error) {
+	exception := findingExceptionResponse{}
+	if err := c.sendJSON(ctx, http.MethodPost, "/api/finding-exceptions", in, &exception, http.StatusCreated); err != nil {
+		return findingExceptionResponse{}, err
+	}
+	return exception, nil
+}
+
+// getFindingException returns the finding exception id.
+func (c clientObj) getFindingException(ctx context.Context, id int64) (findingExceptionResponse, error) {
+	exception := findingExceptionResponse{}
+	if err := c.getJSON(ctx, fmt.Sprintf("/api/finding-exceptions/%d", id), &exception); err != nil {
+		return findingExceptionResponse{}, err
+	}
+	return exception, nil
+}
+
+// updateFindingException updates the finding exception id.
+func (c clientObj) updateFindingException(ctx context.Context, id int64, in findingExceptionUpdateInput) (findingExceptionResponse, error) {
+	exception := findingExceptionResponse{}
+	if err := c.sendJSON(ctx, http.MethodPatch, fmt.Sprintf("/api/finding-exceptions/%d", id), in, &exception, http.StatusOK); err != nil {
+		return findingExceptionResponse{}, err
+	}
+	return exception, nil
+}
+
+// deleteFindingException deletes the finding exception id, Tower reverts
+// the findings it applied to.
+func (c clientObj) deleteFindingException(ctx context.Context, id int64) error {
+	return c.sendJSON(ctx, http.MethodDelete, fmt.Sprintf("/api/finding-exceptions/%d", id), nil, nil, http.StatusOK)
+}
+
+// createScopeExclusion creates a scope exclusion.
+func (c clientObj) createScopeExclusion(ctx context.Context, in scopeExclusionInput) (scopeExclusionResponse, error) {
+	exclusion := scopeExclusionResponse{}
+	if err := c.sendJSON(ctx, http.MethodPost, "/api/scope-exclusions", in, &exclusion, http.StatusCreated); err != nil {
+		return scopeExclusionResponse{}, err
+	}
+	return exclusion, nil
+}
+
+// getScopeExclusion returns the scope exclusion id.
+func (c clientObj) getScopeExclusion(ctx context.Context, id int64) (scopeExclusionResponse, error) {
+	exclusion := scopeExclusionResponse{}
+	if err := c.getJSON(ctx, fmt.Sprintf("/api/scope-exclusions/%d", id), &exclusion); err != nil {
+		return scopeExclusionResponse{}, err
+	}
+	return exclusion, nil
+}
+
+// updateScopeExclusion replaces the rules of the scope exclusion id.
+func (c clientObj) updateScopeExclusion(ctx context.Context, id int64, in scopeExclusionInput) (scopeExclusionResponse, error) {
+	exclusion := scopeExclusionResponse{}
+	if err := c.sendJSON(ctx, http.MethodPut, fmt.Sprintf("/api/scope-exclusions/%d", id), in, &exclusion, http.StatusOK); err != nil {
+		return scopeExclusionResponse{}, err
+	}
+	return exclusion, nil
+}
+
+// deleteScopeExclusion deletes the scope exclusion id.
+func (c clientObj) deleteScopeExclusion(ctx context.Context, id int64) error {
+	return c.sendJSON(ctx, http.MethodDelete, fmt.Sprintf("/api/scope-exclusions/%d", id), nil, nil, http.StatusOK)
+}
+
+// createDiscoverySeed creates a discovery seed.
+func (c clientObj) createDiscoverySeed(ctx context.Context, in discoverySeedInput) (discoverySeedResponse, error) {
+	seed := discoverySeedResponse{}
+	if err := c.sendJSON(ctx, http.MethodPost, "/api/discovery-seeds", in, &seed, http.StatusCreated); err != nil {
+		return discoverySeedResponse{}, err
+	}
+	return seed, nil
+}
+
+// getDiscoverySeed returns the discovery seed id.
+func (c clientObj) getDiscoverySeed(ctx context.Context, id int64) (discoverySeedResponse, error) {
+	seed := discoverySeedResponse{}
+	if err := c.getJSON(ctx, fmt.Sprintf("/api/discovery-seeds/%d", id), &seed); err != nil {
+		return discoverySeedResponse{}, err
+	}
+	return seed, nil
+}
+
+// deleteDiscoverySeed deletes the discovery seed id.
+func (c clientObj) deleteDiscoverySeed(ctx context.Context, id int64) error {
+	return c.sendJSON(ctx, http.MethodDelete, fmt.Sprintf("/api/discovery-seeds/%d", id), nil, nil, http.StatusOK)
+}
@@ -33,16 +33,35 @@ type PanopAssetDataSource struct {
 
 // AssetDataSourceModel describes the resource data model.
 type AssetDataSourceModel struct {
-	AssetId   types.Int64  `tfsdk:"id"`
-	AssetName types.String `tfsdk:"asset_name"`
-	AssetType types.String `tfsdk:"asset_type"`
-	ZoneId    types.Int64  `tfsdk:"zone_id"`
+	AssetId   types.Int64    `tfsdk:"id"`
+	AssetName AssetNameValue `tfsdk:"asset_name"`
+	AssetType types.String   `tfsdk:"asset_type"`
+	ZoneId    types.Int64    `tfsdk:"zone_id"`
+	Hostname  types.String   `tfsdk:"hostname"`
+	Scheme    types.String   `tfsdk:"scheme"`
+	Port      types.Int64    `tfsdk:"port"`
+	Path      types.String   `tfsdk:"path"`
+	Tags      types.Map      `tfsdk:"tags"`
+
+	Criticality  types.String `tfsdk:"criticality"`
+	OwnerEmail   types.String `tfsdk:"owner_email"`
+	BusinessUnit types.String `tfsdk:"business_unit"`
+	Description  types.String `tfsdk:"description"`
+
+	Status          types.String `tfsdk:"status"`
+	FirstSeen       types.String `tfsdk:"first_seen"`
+	LastSeen        types.String `tfsdk:"last_seen"`
+	ResolvedIPs     types.List   `tfsdk:"resolved_ips"`
+	DiscoverySource types.String `tfsdk:"discovery_source"`
+	MonitoringState types.String `tfsdk:"monitoring_state"`
+	PauseUntil      types.String `tfsdk:"pause_until"`
 }
 
 // PanopAssetDataSourceModel maps the data source schema data.
 type PanopAssetDataSourceModel struct {
 	ZoneId   types.Int64            `tfsdk:"zone_id"`
 	ZoneType types.String           `tfsdk:"zone_type"`
+	Tags     types.Map              `tfsdk:"tags"`
 	Assets   []AssetDataSourceModel `tfsdk:"assets"`
 }
 
@@ -66,12 +85,19 @@ func (d *PanopAssetDataSource) Schema(ctx context.Context, req datasource.Schema
 				Optional:    true,
 			},
 
+			"tags": schema.MapAttribute{
+				Description: "Tags Filter, only assets holding all these tags with the same values are returned",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+
 			"assets": schema.ListNestedAttribute{
 				Computed: true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"asset_name": schema.StringAttribute{
 							Description: "Asset Name",
+							CustomType:  AssetNameType{},
 							Computed:    true,
 						},
 						"asset_type": schema.StringAttribute{
@@ -86,6 +112,72 @@ func (d *PanopAssetDataSource) Schema(ctx context.Context, req datasource.Schema
 							Description: "Zone Id",
 							Computed:    true,
 						},
+						"hostname": schema.StringAttribute{
+							Description: "Host name the asset is served on, null for ip and cidr assets",
+							Computed:    true,
+						},
+						"scheme": schema.StringAttribute{
+							Description: "URL scheme of url assets",
+							Computed:    true,
+						},
+						"port": schema.Int64Attribute{
+							Description: "URL port of url assets",
+							Computed:    true,
+						},
+						"path": schema.StringAttribute{
+							Description: "URL path of url assets",
+							Computed:    true,
+						},
+						"tags": schema.MapAttribute{
+							Description: "Asset Tags",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"criticality": schema.StringAttribute{
+							Description: "Business criticality of the asset",
+							Computed:    true,
+						},
+						"owner_email": schema.StringAttribute{
+							Description: "Email address of the asset owner",
+							Computed:    true,
+						},
+						"business_unit": schema.StringAttribute{
+							Description: "Business unit the asset belongs to",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Description of the asset",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Status of the asset as observed by Tower",
+							Computed:    true,
+						},
+						"first_seen": schema.StringAttribute{
+							Description: "RFC 3339 timestamp of the first observation of the asset",
+							Computed:    true,
+						},
+						"last_seen": schema.StringAttribute{
+							Description: "RFC 3339 timestamp of the last observation of the asset",
+							Computed:    true,
+						},
+						"resolved_ips": schema.ListAttribute{
+							Description: "IP addresses the asset resolved to at its last observation",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"discovery_source": schema.StringAttribute{
+							Description: "How Tower learned of the asset, manual or discovered",
+							Computed:    true,
+						},
+						"monitoring_state": schema.StringAttribute{
+							Description: "Monitoring state of the asset",
+							Computed:    true,
+						},
+						"pause_until": schema.StringAttribute{
+							Description: "RFC 3339 timestamp at which Tower resumes the monitoring of a paused asset",
+							Computed:    true,
+						},
 					},
 				},
 			},
@@ -126,6 +218,12 @@ func (d *PanopAssetDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		return
 	}
 
+	tagsFilter, diags := tagsFromValue(ctx, data.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Tower call
 	urlSvc := url.URL{
 		Scheme: "https",
@@ -147,13 +245,6 @@ func (d *PanopAssetDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		return
 	}
 
-	type AssetResponse struct {
-		AssetId   int64  `json:"id"`
-		AssetName string `json:"asset_name"`
-		AssetType string `json:"asset_type"`
-		ZoneId    int64  `json:"zone_id"`
-	}
-
 	respBody, err := io.ReadAll(httpResp.Body)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create zonne, got error: %s", err))
@@ -166,15 +257,37 @@ func (d *PanopAssetDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		return
 	}
 
-	assets := []AssetResponse{}
+	assets := []assetResponse{}
 	_ = json.Unmarshal(respBody, &assets)
 	// this is the end of tower call
 	for _, asset := range assets {
+		details := newAssetResourceModel(asset)
 		assetModel := AssetDataSourceModel{
-			AssetName: types.StringValue(asset.AssetName),
-			AssetType: types.StringValue(asset.AssetType),
-			AssetId:   types.Int64Value(asset.AssetId),
-			ZoneId:    types.Int64Value(asset.ZoneId),
+			AssetName: details.AssetName,
+			AssetType: details.AssetType,
+			AssetId:   details.Id,
+			ZoneId:    details.ZoneId,
+			Hostname:  details.Hostname,
+			Scheme:    details.Scheme,
+			Port:      details.Port,
+			Path:      details.Path,
+			Tags:      details.Tags,
+
+			Criticality:  details.Criticality,
+			OwnerEmail:   details.OwnerEmail,
+			BusinessUnit: details.BusinessUnit,
+			Description:  details.Description,
+
+			Status:          details.Status,
+			FirstSeen:       details.FirstSeen,
+			LastSeen:        details.LastSeen,
+			ResolvedIPs:     details.ResolvedIPs,
+			DiscoverySource: details.DiscoverySource,
+			MonitoringState: details.MonitoringState,
+			PauseUntil:      details.PauseUntil,
+		}
+		if !hasTags(asset.Tags, tagsFilter) {
+			continue
 		}
 		if assetModel.ZoneId == data.ZoneId || data.ZoneId.IsNull() {
 			data.Assets = append(data.Assets, assetModel)
@@ -0,0 +1,293 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PanopCertificatesDataSource{}
+
+func NewPanopCertificatesDataSource() datasource.DataSource {
+	return &PanopCertificatesDataSource{}
+}
+
+// PanopCertificatesDataSource defines the data source implementation.
+type PanopCertificatesDataSource struct {
+	clientObj
+}
+
+// CertificateModel describes a certificate of the data source data model.
+type CertificateModel struct {
+	AssetId           types.Int64  `tfsdk:"asset_id"`
+	AssetName         types.String `tfsdk:"asset_name"`
+	ZoneId            types.Int64  `tfsdk:"zone_id"`
+	Port              types.Int64  `tfsdk:"port"`
+	Subject           types.String `tfsdk:"subject"`
+	Sans              types.List   `tfsdk:"sans"`
+	Issuer            types.String `tfsdk:"issuer"`
+	SerialNumber      types.String `tfsdk:"serial_number"`
+	FingerprintSha256 types.String `tfsdk:"fingerprint_sha256"`
+	NotBefore         types.String `tfsdk:"not_before"`
+	NotAfter          types.String `tfsdk:"not_after"`
+	DaysRemaining     types.Int64  `tfsdk:"days_remaining"`
+	KeyType           types.String `tfsdk:"key_type"`
+	KeySize           types.Int64  `tfsdk:"key_size"`
+	ValidationErrors  types.List   `tfsdk:"validation_errors"`
+}
+
+// PanopCertificatesDataSourceModel maps the data source schema data.
+type PanopCertificatesDataSourceModel struct {
+	ZoneId            types.Int64        `tfsdk:"zone_id"`
+	AssetId           types.Int64        `tfsdk:"asset_id"`
+	ExpiresWithinDays types.Int64        `tfsdk:"expires_within_days"`
+	Certificates      []CertificateModel `tfsdk:"certificates"`
+}
+
+// certificatesFilter selects certificates, zero fields match every
+// certificate.
+type certificatesFilter struct {
+	ZoneId  int64
+	AssetId int64
+
+	// ExpiresBefore selects certificates expiring before ExpiresBefore,
+	// expired ones included.
+	ExpiresBefore time.Time
+}
+
+// match reports whether the certificate passes the filter. Certificates
+// without a valid not_after are not selected by an ExpiresBefore filter.
+func (f certificatesFilter) match(certificate certificateResponse) bool {
+	if f.ZoneId != 0 && certificate.ZoneId != f.ZoneId {
+		return false
+	}
+	if f.AssetId != 0 && certificate.AssetId != f.AssetId {
+		return false
+	}
+	if !f.ExpiresBefore.IsZero() {
+		notAfter, err := time.Parse(time.RFC3339, certificate.NotAfter)
+		if err != nil || !notAfter.Before(f.ExpiresBefore) {
+			return false
+		}
+	}
+	return true
+}
+
+// filterCertificates returns the certificates passing filter, by asset and
+// port.
+func filterCertificates(certificates []certificateResponse, filter certificatesFilter) []certificateResponse {
+	var filtered []certificateResponse
+	for _, certificate := range certificates {
+		if filter.match(certificate) {
+			filtered = append(filtered, certificate)
+		}
+	}
+	slices.SortFunc(filtered, func(a, b certificateResponse) int {
+		return cmp.Or(
+			cmp.Compare(a.AssetId, b.AssetId),
+			cmp.Compare(a.Port, b.Port),
+		)
+	})
+	return filtered
+}
+
+// daysRemaining returns the whole days left at now before notAfter,
+// negative once the certificate expired, null when notAfter is not a
+// valid timestamp.
+func daysRemaining(notAfter string, now time.Time) types.Int64 {
+	expiry, err := time.Parse(time.RFC3339, notAfter)
+	if err != nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(math.Floor(expiry.Sub(now).Hours() / 24)))
+}
+
+// newCertificateModel maps a Tower certificate to the data source model.
+func newCertificateModel(certificate certificateResponse, now time.Time) CertificateModel {
+	return CertificateModel{
+		AssetId:           types.Int64Value(certificate.AssetId),
+		AssetName:         types.StringValue(certificate.AssetName),
+		ZoneId:            types.Int64Value(certificate.ZoneId),
+		Port:              types.Int64Value(certificate.Port),
+		Subject:           types.StringValue(certificate.Subject),
+		Sans:              newStringsListValue(certificate.Sans),
+		Issuer:            types.StringValue(certificate.Issuer),
+		SerialNumber:      optionalString(certificate.SerialNumber),
+		FingerprintSha256: optionalString(certificate.FingerprintSha256),
+		NotBefore:         optionalString(certificate.NotBefore),
+		NotAfter:          optionalString(certificate.NotAfter),
+		DaysRemaining:     daysRemaining(certificate.NotAfter, now),
+		KeyType:           optionalString(certificate.KeyType),
+		KeySize:           optionalInt64(certificate.KeySize),
+		ValidationErrors:  newStringsListValue(certificate.ValidationErrors),
+	}
+}
+
+func (d *PanopCertificatesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_certificates"
+}
+
+func (d *PanopCertificatesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "TLS certificates Tower observed on the assets, optionally filtered, " +
+			"to alert from `check` blocks on certificates close to expiry or with validation errors",
+
+		Attributes: map[string]schema.Attribute{
+			"zone_id": schema.Int64Attribute{
+				Description: "Zone Id Filter",
+				Optional:    true,
+			},
+			"asset_id": schema.Int64Attribute{
+				Description: "Asset Id Filter",
+				Optional:    true,
+			},
+			"expires_within_days": schema.Int64Attribute{
+				Description: "Expiry Filter, only certificates expiring within this many days, or already expired, are returned",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+
+			"certificates": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"asset_id": schema.Int64Attribute{
+							Description: "Asset Id",
+							Computed:    true,
+						},
+						"asset_name": schema.StringAttribute{
+							Description: "Asset Name",
+							Computed:    true,
+						},
+						"zone_id": schema.Int64Attribute{
+							Description: "Zone Id",
+							Computed:    true,
+						},
+						"port": schema.Int64Attribute{
+							Description: "Port the certificate is served on",
+							Computed:    true,
+						},
+						"subject": schema.StringAttribute{
+							Description: "Subject distinguished name",
+							Computed:    true,
+						},
+						"sans": schema.ListAttribute{
+							Description: "Subject alternative names",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"issuer": schema.StringAttribute{
+							Description: "Issuer distinguished name",
+							Computed:    true,
+						},
+						"serial_number": schema.StringAttribute{
+							Description: "Serial number, in hexadecimal",
+							Computed:    true,
+						},
+						"fingerprint_sha256": schema.StringAttribute{
+							Description: "SHA-256 fingerprint of the certificate, in hexadecimal",
+							Computed:    true,
+						},
+						"not_before": schema.StringAttribute{
+							Description: "RFC 3339 timestamp from which the certificate is valid",
+							Computed:    true,
+						},
+						"not_after": schema.StringAttribute{
+							Description: "RFC 3339 timestamp at which the certificate expires",
+							Computed:    true,
+						},
+						"days_remaining": schema.Int64Attribute{
+							Description: "Whole days left before the certificate expires, negative once expired",
+							Computed:    true,
+						},
+						"key_type": schema.StringAttribute{
+							Description: "Public key type, such as RSA or ECDSA",
+							Computed:    true,
+						},
+						"key_size": schema.Int64Attribute{
+							Description: "Public key size in bits",
+							Computed:    true,
+						},
+						"validation_errors": schema.ListAttribute{
+							Description: "Why clients would reject the certificate, such as expired, hostname_mismatch, self_signed or untrusted_root. Empty for a valid certificate",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *PanopCertificatesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(clientObj)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected clientObj, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.clientObj = client
+}
+
+func (d *PanopCertificatesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PanopCertificatesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Tower call
+	certificates, err := d.listCertificates(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read certificates, got error: %s", err))
+		return
+	}
+
+	now := time.Now()
+	filter := certificatesFilter{
+		ZoneId:  data.ZoneId.ValueInt64(),
+		AssetId: data.AssetId.ValueInt64(),
+	}
+	if !data.ExpiresWithinDays.IsNull() {
+		filter.ExpiresBefore = now.Add(time.Duration(data.ExpiresWithinDays.ValueInt64()) * 24 * time.Hour)
+	}
+	data.Certificates = []CertificateModel{}
+	for _, certificate := range filterCertificates(certificates, filter) {
+		data.Certificates = append(data.Certificates, newCertificateModel(certificate, now))
+	}
+
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
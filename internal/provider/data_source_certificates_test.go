@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"os"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestFilterCertificates(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	certificates := []certificateResponse{
+		{AssetId: 13, ZoneId: 416, Port: 443, NotAfter: "2026-03-10T00:00:00Z"},
+		{AssetId: 12, ZoneId: 416, Port: 8443, NotAfter: "2026-06-01T00:00:00Z"},
+		{AssetId: 12, ZoneId: 416, Port: 443, NotAfter: "2026-02-01T00:00:00Z"},
+		{AssetId: 14, ZoneId: 417, Port: 443},
+	}
+
+	for _, tc := range []struct {
+		name   string
+		filter certificatesFilter
+		want   []string
+	}{
+		{"no filter", certificatesFilter{}, []string{"2026-02-01T00:00:00Z", "2026-06-01T00:00:00Z", "2026-03-10T00:00:00Z", ""}},
+		{"zone", certificatesFilter{ZoneId: 417}, []string{""}},
+		{"asset", certificatesFilter{AssetId: 12}, []string{"2026-02-01T00:00:00Z", "2026-06-01T00:00:00Z"}},
+		{"expiry", certificatesFilter{ExpiresBefore: now.Add(30 * 24 * time.Hour)}, []string{"2026-02-01T00:00:00Z", "2026-03-10T00:00:00Z"}},
+	} {
+		var got []string
+		for _, certificate := range filterCertificates(certificates, tc.filter) {
+			got = append(got, certificate.NotAfter)
+		}
+		if !slices.Equal(got, tc.want) {
+			t.Errorf("%s: expected certificates expiring at %q, got %q", tc.name, tc.want, got)
+		}
+	}
+}
+
+func TestDaysRemaining(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	for _, tc := range []struct {
+		notAfter string
+		want     types.Int64
+	}{
+		{"2026-03-10T12:00:00Z", types.Int64Value(9)},
+		{"2026-03-10T11:00:00Z", types.Int64Value(8)},
+		{"2026-03-01T00:00:00Z", types.Int64Value(-1)},
+		{"", types.Int64Null()},
+	} {
+		if got := daysRemaining(tc.notAfter, now); !got.Equal(tc.want) {
+			t.Errorf("daysRemaining(%q) = %s, expected %s", tc.notAfter, got, tc.want)
+		}
+	}
+}
+
+func TestAccCertificatesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: getProviderConfig(os.Getenv("PANOP_ACCESS_KEY")) + testAccCertificatesDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.panop_certificates.test", "certificates.#"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCertificatesDataSourceConfig = `
+data "panop_certificates" "test" {
+  zone_id             = 337
+  expires_within_days = 30
+}
+`
@@ -0,0 +1,222 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PanopDiscoveredAssetsDataSource{}
+
+func NewPanopDiscoveredAssetsDataSource() datasource.DataSource {
+	return &PanopDiscoveredAssetsDataSource{}
+}
+
+// PanopDiscoveredAssetsDataSource defines the data source implementation.
+type PanopDiscoveredAssetsDataSource struct {
+	clientObj
+}
+
+// DiscoveredAssetModel describes a discovered asset of the data source data
+// model.
+type DiscoveredAssetModel struct {
+	Id        types.Int64  `tfsdk:"id"`
+	AssetType types.String `tfsdk:"asset_type"`
+	Adopted   types.Bool   `tfsdk:"adopted"`
+	Source    types.String `tfsdk:"source"`
+	Status    types.String `tfsdk:"status"`
+	FirstSeen types.String `tfsdk:"first_seen"`
+	LastSeen  types.String `tfsdk:"last_seen"`
+}
+
+// PanopDiscoveredAssetsDataSourceModel maps the data source schema data.
+type PanopDiscoveredAssetsDataSourceModel struct {
+	ZoneId         types.Int64                     `tfsdk:"zone_id"`
+	Source         types.String                    `tfsdk:"source"`
+	IncludeAdopted types.Bool                      `tfsdk:"include_adopted"`
+	Assets         map[string]DiscoveredAssetModel `tfsdk:"assets"`
+}
+
+// discoveredAssets returns the assets of the zone zoneId that Tower
+// discovered on its own and that are not adopted yet, the adopted ones too
+// when includeAdopted is set. Only those found by source are returned when
+// it is not empty.
+func discoveredAssets(assets []assetResponse, zoneId int64, source string, includeAdopted bool) []assetResponse {
+	var discovered []assetResponse
+	for _, asset := range assets {
+		if asset.ZoneId != zoneId {
+			continue
+		}
+		if asset.DiscoverySource != discoverySourceDiscovered && (!includeAdopted || asset.DiscoverySource != discoverySourceAdopted) {
+			continue
+		}
+		if source != "" && asset.DiscoveredBy != source {
+			continue
+		}
+		discovered = append(discovered, asset)
+	}
+	return discovered
+}
+
+// duplicateAssetNames returns the ids of the assets sharing a name, by
+// name.
+func duplicateAssetNames(assets []assetResponse) map[string]string {
+	ids := map[string][]string{}
+	for _, asset := range assets {
+		ids[asset.AssetName] = append(ids[asset.AssetName], fmt.Sprint(asset.AssetId))
+	}
+
+	duplicates := map[string]string{}
+	for name, assetIds := range ids {
+		if len(assetIds) > 1 {
+			duplicates[name] = strings.Join(assetIds, ", ")
+		}
+	}
+	return duplicates
+}
+
+func (d *PanopDiscoveredAssetsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_discovered_assets"
+}
+
+func (d *PanopDiscoveredAssetsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Assets Tower discovered on its own in a zone and not yet adopted, rather than registered with `panop_asset` or the API. " +
+			"`assets` is keyed by asset name to be used as the `for_each` of `panop_asset` resources and `import` blocks adopting them, " +
+			"with `include_adopted` set so that adopted assets stay listed and the keys are stable",
+
+		Attributes: map[string]schema.Attribute{
+			"zone_id": schema.Int64Attribute{
+				Description: "Zone Id",
+				Required:    true,
+			},
+			"source": schema.StringAttribute{
+				Description: "Discovery Source Filter, one of certificate_transparency, passive_dns, dns_bruteforce, reverse_whois, asn or search_engines",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(discoverySources...),
+				},
+			},
+
+			"include_adopted": schema.BoolAttribute{
+				MarkdownDescription: "Whether the assets already adopted by a `panop_asset` resource are listed too, as required to adopt assets with `for_each`. Defaults to false",
+				Optional:            true,
+			},
+
+			"assets": schema.MapNestedAttribute{
+				Description: "Discovered assets by asset name",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "Asset Id",
+							Computed:    true,
+						},
+						"asset_type": schema.StringAttribute{
+							Description: "Asset Type",
+							Computed:    true,
+						},
+						"adopted": schema.BoolAttribute{
+							Description: "Whether the asset is adopted by a panop_asset resource",
+							Computed:    true,
+						},
+						"source": schema.StringAttribute{
+							Description: "Discovery source that found the asset",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Status of the asset as observed by Tower",
+							Computed:    true,
+						},
+						"first_seen": schema.StringAttribute{
+							Description: "RFC 3339 timestamp of the first observation of the asset",
+							Computed:    true,
+						},
+						"last_seen": schema.StringAttribute{
+							Description: "RFC 3339 timestamp of the last observation of the asset",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *PanopDiscoveredAssetsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(clientObj)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected clientObj, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.clientObj = client
+}
+
+func (d *PanopDiscoveredAssetsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PanopDiscoveredAssetsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Tower call
+	assets, err := d.listAssets(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read assets, got error: %s", err))
+		return
+	}
+
+	discovered := discoveredAssets(assets, data.ZoneId.ValueInt64(), data.Source.ValueString(), data.IncludeAdopted.ValueBool())
+
+	// The assets are keyed by name, which Tower only makes unique per type.
+	for name, ids := range duplicateAssetNames(discovered) {
+		resp.Diagnostics.AddError("Duplicate Discovered Asset",
+			fmt.Sprintf("Several discovered assets are named %q (ids %s), narrow the assets down with source.", name, ids))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Assets = map[string]DiscoveredAssetModel{}
+	for _, asset := range discovered {
+		data.Assets[asset.AssetName] = DiscoveredAssetModel{
+			Id:        types.Int64Value(asset.AssetId),
+			AssetType: types.StringValue(asset.AssetType),
+			Adopted:   types.BoolValue(asset.DiscoverySource == discoverySourceAdopted),
+			Source:    optionalString(asset.DiscoveredBy),
+			Status:    optionalString(asset.Status),
+			FirstSeen: optionalString(asset.FirstSeen),
+			LastSeen:  optionalString(asset.LastSeen),
+		}
+	}
+
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"os"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestDiscoveredAssets(t *testing.T) {
+	assets := []assetResponse{
+		{AssetId: 12, ZoneId: 416, DiscoverySource: "manual"},
+		{AssetId: 13, ZoneId: 416, DiscoverySource: "discovered", DiscoveredBy: "passive_dns"},
+		{AssetId: 14, ZoneId: 416, DiscoverySource: "discovered", DiscoveredBy: "certificate_transparency"},
+		{AssetId: 15, ZoneId: 417, DiscoverySource: "discovered", DiscoveredBy: "passive_dns"},
+		{AssetId: 16, ZoneId: 416, DiscoverySource: "adopted", DiscoveredBy: "passive_dns"},
+	}
+
+	for _, tc := range []struct {
+		name           string
+		source         string
+		includeAdopted bool
+		want           []int64
+	}{
+		{"zone", "", false, []int64{13, 14}},
+		{"source", "passive_dns", false, []int64{13}},
+		{"adopted", "passive_dns", true, []int64{13, 16}},
+	} {
+		var got []int64
+		for _, asset := range discoveredAssets(assets, 416, tc.source, tc.includeAdopted) {
+			got = append(got, asset.AssetId)
+		}
+		if !slices.Equal(got, tc.want) {
+			t.Errorf("%s: expected assets %v, got %v", tc.name, tc.want, got)
+		}
+	}
+}
+
+func TestDuplicateAssetNames(t *testing.T) {
+	duplicates := duplicateAssetNames([]assetResponse{
+		{AssetId: 13, AssetName: "www.example.com", AssetType: "dns"},
+		{AssetId: 14, AssetName: "mail.example.com", AssetType: "dns"},
+		{AssetId: 15, AssetName: "www.example.com", AssetType: "web"},
+	})
+
+	if len(duplicates) != 1 || duplicates["www.example.com"] != "13, 15" {
+		t.Errorf("expected www.example.com to be duplicated by assets 13 and 15, got %v", duplicates)
+	}
+}
+
+func TestAccDiscoveredAssetsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: getProviderConfig(os.Getenv("PANOP_ACCESS_KEY")) + testAccDiscoveredAssetsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.panop_discovered_assets.test", "zone_id", "337"),
+					resource.TestCheckResourceAttrSet("data.panop_discovered_assets.test", "assets.%"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDiscoveredAssetsDataSourceConfig = `
+data "panop_discovered_assets" "test" {
+  zone_id = 337
+}
+`
@@ -0,0 +1,221 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PanopFindingsDataSource{}
+
+func NewPanopFindingsDataSource() datasource.DataSource {
+	return &PanopFindingsDataSource{}
+}
+
+// PanopFindingsDataSource defines the data source implementation.
+type PanopFindingsDataSource struct {
+	clientObj
+}
+
+// FindingModel describes a finding of the data source data model.
+type FindingModel struct {
+	Id        types.Int64   `tfsdk:"id"`
+	Title     types.String  `tfsdk:"title"`
+	Severity  types.String  `tfsdk:"severity"`
+	Cvss      types.Float64 `tfsdk:"cvss"`
+	Status    types.String  `tfsdk:"status"`
+	RuleId    types.String  `tfsdk:"rule_id"`
+	AssetId   types.Int64   `tfsdk:"asset_id"`
+	AssetName types.String  `tfsdk:"asset_name"`
+	ZoneId    types.Int64   `tfsdk:"zone_id"`
+	FirstSeen types.String  `tfsdk:"first_seen"`
+	LastSeen  types.String  `tfsdk:"last_seen"`
+}
+
+// PanopFindingsDataSourceModel maps the data source schema data.
+type PanopFindingsDataSourceModel struct {
+	ZoneId     types.Int64    `tfsdk:"zone_id"`
+	AssetId    types.Int64    `tfsdk:"asset_id"`
+	Severity   types.String   `tfsdk:"severity"`
+	Status     types.String   `tfsdk:"status"`
+	MinAgeDays types.Int64    `tfsdk:"min_age_days"`
+	Findings   []FindingModel `tfsdk:"findings"`
+}
+
+// newFindingModel maps a Tower finding to the data source model.
+func newFindingModel(finding findingResponse) FindingModel {
+	return FindingModel{
+		Id:        types.Int64Value(finding.Id),
+		Title:     types.StringValue(finding.Title),
+		Severity:  types.StringValue(finding.Severity),
+		Cvss:      types.Float64Value(finding.Cvss),
+		Status:    types.StringValue(finding.Status),
+		RuleId:    optionalString(finding.RuleId),
+		AssetId:   types.Int64Value(finding.AssetId),
+		AssetName: types.StringValue(finding.AssetName),
+		ZoneId:    types.Int64Value(finding.ZoneId),
+		FirstSeen: optionalString(finding.FirstSeen),
+		LastSeen:  optionalString(finding.LastSeen),
+	}
+}
+
+func (d *PanopFindingsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_findings"
+}
+
+func (d *PanopFindingsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Findings Tower raised on the zones and assets, optionally filtered",
+
+		Attributes: map[string]schema.Attribute{
+			"zone_id": schema.Int64Attribute{
+				Description: "Zone Id Filter",
+				Optional:    true,
+			},
+			"asset_id": schema.Int64Attribute{
+				Description: "Asset Id Filter",
+				Optional:    true,
+			},
+			"severity": schema.StringAttribute{
+				Description: "Minimum Severity Filter, one of info, low, medium, high or critical. Only findings of this severity or higher are returned",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(severities...),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "Status Filter, one of open, accepted, false_positive or resolved",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(findingStatuses...),
+				},
+			},
+			"min_age_days": schema.Int64Attribute{
+				Description: "Age Filter, only findings first seen at least this many days ago, or without a first seen date, are returned",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+
+			"findings": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "Finding Id",
+							Computed:    true,
+						},
+						"title": schema.StringAttribute{
+							Description: "Finding Title",
+							Computed:    true,
+						},
+						"severity": schema.StringAttribute{
+							Description: "Finding Severity",
+							Computed:    true,
+						},
+						"cvss": schema.Float64Attribute{
+							Description: "CVSS base score of the finding",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Finding Status",
+							Computed:    true,
+						},
+						"rule_id": schema.StringAttribute{
+							Description: "Id of the rule that raised the finding",
+							Computed:    true,
+						},
+						"asset_id": schema.Int64Attribute{
+							Description: "Asset Id",
+							Computed:    true,
+						},
+						"asset_name": schema.StringAttribute{
+							Description: "Asset Name",
+							Computed:    true,
+						},
+						"zone_id": schema.Int64Attribute{
+							Description: "Zone Id",
+							Computed:    true,
+						},
+						"first_seen": schema.StringAttribute{
+							Description: "RFC 3339 timestamp of the first observation of the finding",
+							Computed:    true,
+						},
+						"last_seen": schema.StringAttribute{
+							Description: "RFC 3339 timestamp of the last observation of the finding",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *PanopFindingsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(clientObj)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected clientObj, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.clientObj = client
+}
+
+func (d *PanopFindingsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PanopFindingsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Tower call
+	findings, err := d.listFindings(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read findings, got error: %s", err))
+		return
+	}
+
+	filter := findingsFilter{
+		ZoneId:      data.ZoneId.ValueInt64(),
+		AssetId:     data.AssetId.ValueInt64(),
+		MinSeverity: data.Severity.ValueString(),
+		Status:      data.Status.ValueString(),
+		MinAge:      time.Duration(data.MinAgeDays.ValueInt64()) * 24 * time.Hour,
+	}
+	data.Findings = []FindingModel{}
+	for _, finding := range filterFindings(findings, filter, time.Now()) {
+		data.Findings = append(data.Findings, newFindingModel(finding))
+	}
+
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFindingsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: getProviderConfig(os.Getenv("PANOP_ACCESS_KEY")) + testAccFindingsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.panop_findings.test", "findings.#"),
+				),
+			},
+			// Invalid severity testing
+			{
+				Config: getProviderConfig(os.Getenv("PANOP_ACCESS_KEY")) + `
+data "panop_findings" "test" {
+  severity = "urgent"
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+		},
+	})
+}
+
+const testAccFindingsDataSourceConfig = `
+data "panop_findings" "test" {
+  zone_id  = 337
+  severity = "high"
+  status   = "open"
+}
+`
@@ -0,0 +1,200 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// defaultSeverityThreshold is the severity threshold of panop_posture when
+// not set.
+const defaultSeverityThreshold = "high"
+
+// postureCountsAttrTypes are the attribute types of the posture counts
+// object.
+var postureCountsAttrTypes = map[string]attr.Type{
+	"critical": types.Int64Type,
+	"high":     types.Int64Type,
+	"medium":   types.Int64Type,
+	"low":      types.Int64Type,
+	"info":     types.Int64Type,
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PanopPostureDataSource{}
+
+func NewPanopPostureDataSource() datasource.DataSource {
+	return &PanopPostureDataSource{}
+}
+
+// PanopPostureDataSource defines the data source implementation.
+type PanopPostureDataSource struct {
+	clientObj
+}
+
+// PanopPostureDataSourceModel maps the data source schema data.
+type PanopPostureDataSourceModel struct {
+	ZoneId            types.Int64  `tfsdk:"zone_id"`
+	AssetId           types.Int64  `tfsdk:"asset_id"`
+	SeverityThreshold types.String `tfsdk:"severity_threshold"`
+	MaxAgeDays        types.Int64  `tfsdk:"max_age_days"`
+	Pass              types.Bool   `tfsdk:"pass"`
+	FailingCount      types.Int64  `tfsdk:"failing_count"`
+	FailingFindingIds types.List   `tfsdk:"failing_finding_ids"`
+	OpenCounts        types.Object `tfsdk:"open_counts"`
+}
+
+func (d *PanopPostureDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_posture"
+}
+
+func (d *PanopPostureDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Security posture of the zones and assets, evaluated from their open findings. " +
+			"`pass` is false when an open finding reaches the severity threshold and is older than `max_age_days`, " +
+			"to be asserted in `check` blocks or lifecycle preconditions",
+
+		Attributes: map[string]schema.Attribute{
+			"zone_id": schema.Int64Attribute{
+				MarkdownDescription: "Zone Id Filter",
+				Optional:            true,
+			},
+			"asset_id": schema.Int64Attribute{
+				MarkdownDescription: "Asset Id Filter",
+				Optional:            true,
+			},
+			"severity_threshold": schema.StringAttribute{
+				MarkdownDescription: "Lowest severity of a failing finding, one of `info`, `low`, `medium`, `high` or `critical`. Defaults to `high`",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(severities...),
+				},
+			},
+			"max_age_days": schema.Int64Attribute{
+				MarkdownDescription: "Days an open finding reaching the threshold is tolerated before it fails the posture, such as a remediation SLA. Findings without a first seen date are not tolerated. Every such finding fails the posture when not set",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"pass": schema.BoolAttribute{
+				MarkdownDescription: "Whether no open finding fails the posture",
+				Computed:            true,
+			},
+			"failing_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of open findings failing the posture",
+				Computed:            true,
+			},
+			"failing_finding_ids": schema.ListAttribute{
+				MarkdownDescription: "Ids of the open findings failing the posture",
+				ElementType:         types.Int64Type,
+				Computed:            true,
+			},
+			"open_counts": schema.SingleNestedAttribute{
+				MarkdownDescription: "Open findings by severity, failing or not",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"critical": schema.Int64Attribute{
+						MarkdownDescription: "Open critical findings",
+						Computed:            true,
+					},
+					"high": schema.Int64Attribute{
+						MarkdownDescription: "Open high findings",
+						Computed:            true,
+					},
+					"medium": schema.Int64Attribute{
+						MarkdownDescription: "Open medium findings",
+						Computed:            true,
+					},
+					"low": schema.Int64Attribute{
+						MarkdownDescription: "Open low findings",
+						Computed:            true,
+					},
+					"info": schema.Int64Attribute{
+						MarkdownDescription: "Open informational findings",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *PanopPostureDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(clientObj)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected clientObj, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.clientObj = client
+}
+
+func (d *PanopPostureDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PanopPostureDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Tower call
+	findings, err := d.listFindings(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read findings, got error: %s", err))
+		return
+	}
+
+	threshold := defaultSeverityThreshold
+	if !data.SeverityThreshold.IsNull() {
+		threshold = data.SeverityThreshold.ValueString()
+	}
+
+	open, failing := postureFindings(findings, findingsFilter{
+		ZoneId:  data.ZoneId.ValueInt64(),
+		AssetId: data.AssetId.ValueInt64(),
+	}, threshold, time.Duration(data.MaxAgeDays.ValueInt64())*24*time.Hour, time.Now())
+
+	failingIds := make([]attr.Value, 0, len(failing))
+	for _, finding := range failing {
+		failingIds = append(failingIds, types.Int64Value(finding.Id))
+	}
+	counts := make(map[string]attr.Value, len(severities))
+	for severity, count := range countBySeverity(open) {
+		counts[severity] = types.Int64Value(count)
+	}
+
+	data.Pass = types.BoolValue(len(failing) == 0)
+	data.FailingCount = types.Int64Value(int64(len(failing)))
+	data.FailingFindingIds = types.ListValueMust(types.Int64Type, failingIds)
+	data.OpenCounts = types.ObjectValueMust(postureCountsAttrTypes, counts)
+
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,37 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPostureDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: getProviderConfig(os.Getenv("PANOP_ACCESS_KEY")) + testAccPostureDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.panop_posture.test", "pass"),
+					resource.TestCheckResourceAttrSet("data.panop_posture.test", "failing_count"),
+					resource.TestCheckResourceAttrSet("data.panop_posture.test", "open_counts.critical"),
+				),
+			},
+		},
+	})
+}
+
+const testAccPostureDataSourceConfig = `
+data "panop_posture" "test" {
+  zone_id            = 337
+  severity_threshold = "critical"
+  max_age_days       = 30
+}
+`
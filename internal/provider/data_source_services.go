@@ -0,0 +1,265 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// serviceProtocols lists the transport protocols of the services Tower
+// observes.
+var serviceProtocols = []string{"tcp", "udp"}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PanopServicesDataSource{}
+
+func NewPanopServicesDataSource() datasource.DataSource {
+	return &PanopServicesDataSource{}
+}
+
+// PanopServicesDataSource defines the data source implementation.
+type PanopServicesDataSource struct {
+	clientObj
+}
+
+// ServiceModel describes a service of the data source data model.
+type ServiceModel struct {
+	AssetId   types.Int64  `tfsdk:"asset_id"`
+	AssetName types.String `tfsdk:"asset_name"`
+	ZoneId    types.Int64  `tfsdk:"zone_id"`
+	Port      types.Int64  `tfsdk:"port"`
+	Protocol  types.String `tfsdk:"protocol"`
+	Service   types.String `tfsdk:"service"`
+	Product   types.String `tfsdk:"product"`
+	Version   types.String `tfsdk:"version"`
+	Banner    types.String `tfsdk:"banner"`
+	FirstSeen types.String `tfsdk:"first_seen"`
+	LastSeen  types.String `tfsdk:"last_seen"`
+}
+
+// PanopServicesDataSourceModel maps the data source schema data.
+type PanopServicesDataSourceModel struct {
+	ZoneId    types.Int64    `tfsdk:"zone_id"`
+	AssetId   types.Int64    `tfsdk:"asset_id"`
+	PortRange types.String   `tfsdk:"port_range"`
+	Protocol  types.String   `tfsdk:"protocol"`
+	Services  []ServiceModel `tfsdk:"services"`
+}
+
+// servicesFilter selects services, zero fields match every service.
+type servicesFilter struct {
+	ZoneId    int64
+	AssetId   int64
+	FirstPort int64
+	LastPort  int64
+	Protocol  string
+}
+
+// match reports whether service passes the filter.
+func (f servicesFilter) match(service serviceResponse) bool {
+	if f.ZoneId != 0 && service.ZoneId != f.ZoneId {
+		return false
+	}
+	if f.AssetId != 0 && service.AssetId != f.AssetId {
+		return false
+	}
+	if f.FirstPort != 0 && (service.Port < f.FirstPort || service.Port > f.LastPort) {
+		return false
+	}
+	if f.Protocol != "" && service.Protocol != f.Protocol {
+		return false
+	}
+	return true
+}
+
+// filterServices returns the services passing filter, by asset, port and
+// protocol.
+func filterServices(services []serviceResponse, filter servicesFilter) []serviceResponse {
+	var filtered []serviceResponse
+	for _, service := range services {
+		if filter.match(service) {
+			filtered = append(filtered, service)
+		}
+	}
+	slices.SortFunc(filtered, func(a, b serviceResponse) int {
+		return cmp.Or(
+			cmp.Compare(a.AssetId, b.AssetId),
+			cmp.Compare(a.Port, b.Port),
+			cmp.Compare(a.Protocol, b.Protocol),
+		)
+	})
+	return filtered
+}
+
+// newServiceModel maps a Tower service to the data source model.
+func newServiceModel(service serviceResponse) ServiceModel {
+	return ServiceModel{
+		AssetId:   types.Int64Value(service.AssetId),
+		AssetName: types.StringValue(service.AssetName),
+		ZoneId:    types.Int64Value(service.ZoneId),
+		Port:      types.Int64Value(service.Port),
+		Protocol:  types.StringValue(service.Protocol),
+		Service:   optionalString(service.Service),
+		Product:   optionalString(service.Product),
+		Version:   optionalString(service.Version),
+		Banner:    optionalString(service.Banner),
+		FirstSeen: optionalString(service.FirstSeen),
+		LastSeen:  optionalString(service.LastSeen),
+	}
+}
+
+func (d *PanopServicesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_services"
+}
+
+func (d *PanopServicesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Network services Tower observed on the assets, one per open port, optionally filtered",
+
+		Attributes: map[string]schema.Attribute{
+			"zone_id": schema.Int64Attribute{
+				Description: "Zone Id Filter",
+				Optional:    true,
+			},
+			"asset_id": schema.Int64Attribute{
+				Description: "Asset Id Filter",
+				Optional:    true,
+			},
+			"port_range": schema.StringAttribute{
+				Description: "Port Filter, a port such as 443 or a port range such as 8000-8100",
+				Optional:    true,
+				Validators: []validator.String{
+					portRangeValidator{},
+				},
+			},
+			"protocol": schema.StringAttribute{
+				Description: "Protocol Filter, tcp or udp",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(serviceProtocols...),
+				},
+			},
+
+			"services": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"asset_id": schema.Int64Attribute{
+							Description: "Asset Id",
+							Computed:    true,
+						},
+						"asset_name": schema.StringAttribute{
+							Description: "Asset Name",
+							Computed:    true,
+						},
+						"zone_id": schema.Int64Attribute{
+							Description: "Zone Id",
+							Computed:    true,
+						},
+						"port": schema.Int64Attribute{
+							Description: "Open Port",
+							Computed:    true,
+						},
+						"protocol": schema.StringAttribute{
+							Description: "Transport protocol, tcp or udp",
+							Computed:    true,
+						},
+						"service": schema.StringAttribute{
+							Description: "Detected service, such as https or ssh",
+							Computed:    true,
+						},
+						"product": schema.StringAttribute{
+							Description: "Detected product, such as nginx or OpenSSH",
+							Computed:    true,
+						},
+						"version": schema.StringAttribute{
+							Description: "Detected product version",
+							Computed:    true,
+						},
+						"banner": schema.StringAttribute{
+							Description: "Banner the service answered with",
+							Computed:    true,
+						},
+						"first_seen": schema.StringAttribute{
+							Description: "RFC 3339 timestamp of the first observation of the open port",
+							Computed:    true,
+						},
+						"last_seen": schema.StringAttribute{
+							Description: "RFC 3339 timestamp of the last observation of the open port",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *PanopServicesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(clientObj)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected clientObj, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.clientObj = client
+}
+
+func (d *PanopServicesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PanopServicesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Tower call
+	services, err := d.listServices(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read services, got error: %s", err))
+		return
+	}
+
+	filter := servicesFilter{
+		ZoneId:   data.ZoneId.ValueInt64(),
+		AssetId:  data.AssetId.ValueInt64(),
+		Protocol: data.Protocol.ValueString(),
+	}
+	// The port range syntax was checked by its validator.
+	if !data.PortRange.IsNull() {
+		first, last, _ := parsePortRange(data.PortRange.ValueString())
+		filter.FirstPort, filter.LastPort = int64(first), int64(last)
+	}
+	data.Services = []ServiceModel{}
+	for _, service := range filterServices(services, filter) {
+		data.Services = append(data.Services, newServiceModel(service))
+	}
+
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
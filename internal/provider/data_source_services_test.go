@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"os"
+	"regexp"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestFilterServices(t *testing.T) {
+	services := []serviceResponse{
+		{AssetId: 13, ZoneId: 416, Port: 443, Protocol: "tcp"},
+		{AssetId: 12, ZoneId: 416, Port: 8080, Protocol: "tcp"},
+		{AssetId: 12, ZoneId: 416, Port: 53, Protocol: "udp"},
+		{AssetId: 14, ZoneId: 417, Port: 22, Protocol: "tcp"},
+	}
+
+	for _, tc := range []struct {
+		name   string
+		filter servicesFilter
+		want   []int64
+	}{
+		{"no filter", servicesFilter{}, []int64{53, 8080, 443, 22}},
+		{"zone", servicesFilter{ZoneId: 416}, []int64{53, 8080, 443}},
+		{"asset", servicesFilter{AssetId: 12}, []int64{53, 8080}},
+		{"port range", servicesFilter{FirstPort: 1, LastPort: 1024}, []int64{53, 443, 22}},
+		{"single port", servicesFilter{FirstPort: 443, LastPort: 443}, []int64{443}},
+		{"protocol", servicesFilter{Protocol: "udp"}, []int64{53}},
+	} {
+		var got []int64
+		for _, service := range filterServices(services, tc.filter) {
+			got = append(got, service.Port)
+		}
+		if !slices.Equal(got, tc.want) {
+			t.Errorf("%s: expected ports %v, got %v", tc.name, tc.want, got)
+		}
+	}
+}
+
+func TestAccServicesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: getProviderConfig(os.Getenv("PANOP_ACCESS_KEY")) + testAccServicesDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.panop_services.test", "services.#"),
+				),
+			},
+			// Invalid port range testing
+			{
+				Config: getProviderConfig(os.Getenv("PANOP_ACCESS_KEY")) + `
+data "panop_services" "test" {
+  port_range = "0-1024"
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid Port Range"),
+			},
+		},
+	})
+}
+
+const testAccServicesDataSourceConfig = `
+data "panop_services" "test" {
+  zone_id    = 337
+  port_range = "1-1024"
+  protocol   = "tcp"
+}
+`
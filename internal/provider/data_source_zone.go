@@ -33,11 +33,21 @@ type PanopZoneDataSource struct {
 
 // ZoneResourceModel describes the resource data model.
 type ZoneModel struct {
-	ZoneName types.String `tfsdk:"zone_name"`
-	TenantId types.Int64  `tfsdk:"tenant_id"`
-	Id       types.Int64  `tfsdk:"id"`
-	ZoneType types.String `tfsdk:"zone_type"`
-	Token    types.String `tfsdk:"token"`
+	ZoneName  DomainNameValue `tfsdk:"zone_name"`
+	TenantId  types.Int64     `tfsdk:"tenant_id"`
+	Id        types.Int64     `tfsdk:"id"`
+	ZoneType  types.String    `tfsdk:"zone_type"`
+	Token     types.String    `tfsdk:"token"`
+	Validated types.Bool      `tfsdk:"validated"`
+	Tags      types.Map       `tfsdk:"tags"`
+
+	Criticality  types.String `tfsdk:"criticality"`
+	OwnerEmail   types.String `tfsdk:"owner_email"`
+	BusinessUnit types.String `tfsdk:"business_unit"`
+	Description  types.String `tfsdk:"description"`
+
+	PolicyId      types.Int64             `tfsdk:"policy_id"`
+	AutoDiscovery *ZoneAutoDiscoveryModel `tfsdk:"auto_discovery"`
 }
 
 // coffeesDataSourceModel maps the data source schema data.
@@ -60,7 +70,8 @@ func (d *PanopZoneDataSource) Schema(ctx context.Context, req datasource.SchemaR
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"zone_name": schema.StringAttribute{
-							Computed: true,
+							CustomType: DomainNameType{},
+							Computed:   true,
 						},
 						"tenant_id": schema.Int64Attribute{
 							Computed: true,
@@ -75,6 +86,43 @@ func (d *PanopZoneDataSource) Schema(ctx context.Context, req datasource.SchemaR
 							Computed:  true,
 							Sensitive: true,
 						},
+						"validated": schema.BoolAttribute{
+							Computed: true,
+						},
+						"tags": schema.MapAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"criticality": schema.StringAttribute{
+							Computed: true,
+						},
+						"owner_email": schema.StringAttribute{
+							Computed: true,
+						},
+						"business_unit": schema.StringAttribute{
+							Computed: true,
+						},
+						"description": schema.StringAttribute{
+							Computed: true,
+						},
+						"policy_id": schema.Int64Attribute{
+							Computed: true,
+						},
+						"auto_discovery": schema.SingleNestedAttribute{
+							Computed: true,
+							Attributes: map[string]schema.Attribute{
+								"enabled": schema.BoolAttribute{
+									Computed: true,
+								},
+								"depth": schema.Int64Attribute{
+									Computed: true,
+								},
+								"sources": schema.SetAttribute{
+									ElementType: types.StringType,
+									Computed:    true,
+								},
+							},
+						},
 					},
 				},
 			},
@@ -137,12 +185,21 @@ func (d *PanopZoneDataSource) Read(ctx context.Context, req datasource.ReadReque
 	}
 
 	type ZoneResponse struct {
-		Id        uint   `json:"id"`
-		ZoneName  string `json:"zone_name"`
-		ZoneType  string `json:"zone_type"`
-		Validated bool   `json:"validated"`
-		Token     string `json:"token"`
-		TenantId  uint   `json:"tenant_id"`
+		Id        uint              `json:"id"`
+		ZoneName  string            `json:"zone_name"`
+		ZoneType  string            `json:"zone_type"`
+		Validated bool              `json:"validated"`
+		Token     string            `json:"token"`
+		TenantId  uint              `json:"tenant_id"`
+		Tags      map[string]string `json:"tags"`
+
+		Criticality  string `json:"criticality"`
+		OwnerEmail   string `json:"owner_email"`
+		BusinessUnit string `json:"business_unit"`
+		Description  string `json:"description"`
+
+		PolicyId      int64              `json:"policy_id"`
+		AutoDiscovery *zoneAutoDiscovery `json:"auto_discovery"`
 	}
 
 	respBody, err := io.ReadAll(httpResp.Body)
@@ -162,11 +219,21 @@ func (d *PanopZoneDataSource) Read(ctx context.Context, req datasource.ReadReque
 	// this is the end of tower call
 	for _, zone := range zones {
 		zoneModel := ZoneModel{
-			ZoneName: types.StringValue(zone.ZoneName),
-			TenantId: types.Int64Value(int64(zone.TenantId)),
-			Id:       types.Int64Value(int64(zone.Id)),
-			ZoneType: types.StringValue(zone.ZoneType),
-			Token:    types.StringValue(zone.Token),
+			ZoneName:  NewDomainNameValue(zone.ZoneName),
+			TenantId:  types.Int64Value(int64(zone.TenantId)),
+			Id:        types.Int64Value(int64(zone.Id)),
+			ZoneType:  types.StringValue(zone.ZoneType),
+			Token:     types.StringValue(zone.Token),
+			Validated: types.BoolValue(zone.Validated),
+			Tags:      newTagsValue(types.MapNull(types.StringType), zone.Tags),
+
+			Criticality:  optionalString(zone.Criticality),
+			OwnerEmail:   optionalString(zone.OwnerEmail),
+			BusinessUnit: optionalString(zone.BusinessUnit),
+			Description:  optionalString(zone.Description),
+
+			PolicyId:      optionalInt64(zone.PolicyId),
+			AutoDiscovery: newZoneAutoDiscoveryModel(nil, zone.AutoDiscovery),
 		}
 		data.Zones = append(data.Zones, zoneModel)
 	}
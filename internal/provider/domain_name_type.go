@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"golang.org/x/net/idna"
+)
+
+// normalizeDomainName returns the canonical form of a domain name: lower
+// case, without trailing dot and with IDN labels in their ASCII (punycode)
+// form. Names that cannot be converted are only lowered and trimmed.
+func normalizeDomainName(name string) string {
+	name = strings.ToLower(strings.TrimSuffix(name, "."))
+	if ascii, err := idna.ToASCII(name); err == nil {
+		return ascii
+	}
+	return name
+}
+
+var _ basetypes.StringTypable = DomainNameType{}
+
+// DomainNameType is a string type for domain names, its values are
+// semantically equal when they designate the same name.
+type DomainNameType struct {
+	basetypes.StringType
+}
+
+func (t DomainNameType) String() string {
+	return "DomainNameType"
+}
+
+func (t DomainNameType) ValueType(ctx context.Context) attr.Value {
+	return DomainNameValue{}
+}
+
+func (t DomainNameType) Equal(o attr.Type) bool {
+	other, ok := o.(DomainNameType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t DomainNameType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return DomainNameValue{StringValue: in}, nil
+}
+
+func (t DomainNameType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+var _ basetypes.StringValuableWithSemanticEquals = DomainNameValue{}
+
+// DomainNameValue is a value of DomainNameType.
+type DomainNameValue struct {
+	basetypes.StringValue
+}
+
+// NewDomainNameValue returns a known DomainNameValue holding name.
+func NewDomainNameValue(name string) DomainNameValue {
+	return DomainNameValue{StringValue: basetypes.NewStringValue(name)}
+}
+
+// NewDomainNameNull returns a null DomainNameValue.
+func NewDomainNameNull() DomainNameValue {
+	return DomainNameValue{StringValue: basetypes.NewStringNull()}
+}
+
+func (v DomainNameValue) Type(ctx context.Context) attr.Type {
+	return DomainNameType{}
+}
+
+func (v DomainNameValue) Equal(o attr.Value) bool {
+	other, ok := o.(DomainNameValue)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals reports whether both names designate the same
+// domain, ignoring case, a trailing dot and the IDN form used.
+func (v DomainNameValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(DomainNameValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	return normalizeDomainName(v.ValueString()) == normalizeDomainName(newValue.ValueString()), diags
+}
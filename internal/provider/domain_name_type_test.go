@@ -0,0 +1,32 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+)
+
+func TestDomainNameValueSemanticEquals(t *testing.T) {
+	for _, tc := range []struct {
+		prior, current string
+		want           bool
+	}{
+		{"example.com", "example.com", true},
+		{"Example.COM", "example.com", true},
+		{"example.com.", "example.com", true},
+		{"bücher.example", "xn--bcher-kva.example", true},
+		{"BÜCHER.example.", "xn--bcher-kva.example", true},
+		{"www.example.com", "example.com", false},
+		{"bucher.example", "xn--bcher-kva.example", false},
+	} {
+		equal, diags := NewDomainNameValue(tc.prior).StringSemanticEquals(context.Background(), NewDomainNameValue(tc.current))
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		if equal != tc.want {
+			t.Errorf("StringSemanticEquals(%q, %q) = %t, want %t", tc.prior, tc.current, equal, tc.want)
+		}
+	}
+}
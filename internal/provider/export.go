@@ -0,0 +1,275 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// ExportOptions configures Export.
+type ExportOptions struct {
+	Host          string
+	AccessKey     string
+	SkipTLSVerify bool
+	// OutputDir is the directory the .tf files are written to. It is
+	// created if it does not exist.
+	OutputDir string
+	// IncludeDiscovered exports the assets Tower discovered on its own and
+	// that are not adopted yet, which are left to panop_discovered_assets
+	// otherwise. Adopted assets are always exported.
+	IncludeDiscovered bool
+}
+
+// Export reads every zone and asset of the tenant and writes, for each
+// zone, a .tf file holding panop_zone, panop_asset and panop_ip_range
+// resources along with the import blocks that bind them to the existing Tower objects. Existing
+// files are never overwritten. It returns the paths of the written files.
+func Export(ctx context.Context, opts ExportOptions) ([]string, error) {
+	client := newClientObj(opts.Host, opts.AccessKey, opts.SkipTLSVerify)
+
+	zones, err := client.listZones(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list zones: %w", err)
+	}
+	assets, err := client.listAssets(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list assets: %w", err)
+	}
+
+	files := renderExport(zones, assets, opts.IncludeDiscovered)
+
+	if err := os.MkdirAll(opts.OutputDir, 0o755); err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	written := make([]string, 0, len(names))
+	for _, name := range names {
+		filePath := filepath.Join(opts.OutputDir, name)
+		f, err := os.OpenFile(filePath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+		if err != nil {
+			return written, err
+		}
+		_, err = f.Write(files[name])
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return written, err
+		}
+		written = append(written, filePath)
+	}
+
+	return written, nil
+}
+
+// renderExport returns the content of the exported configuration, keyed by
+// file name. Assets whose zone is unknown are written to
+// assets_unzoned.tf with a literal zone_id. Discovered assets that are not
+// adopted yet are left out unless includeDiscovered is set.
+func renderExport(zones []zoneResponse, assets []assetResponse, includeDiscovered bool) map[string][]byte {
+	sort.Slice(zones, func(i, j int) bool { return zones[i].Id < zones[j].Id })
+	sort.Slice(assets, func(i, j int) bool { return assets[i].AssetId < assets[j].AssetId })
+
+	labels := map[string]bool{}
+	files := map[string][]byte{}
+
+	zoneFiles := map[int64]*hclwrite.File{}
+	zoneLabels := map[int64]string{}
+	var zoneOrder []int64
+
+	for _, zone := range zones {
+		label := uniqueLabel(labels, zone.ZoneName)
+		zoneLabels[zone.Id] = label
+
+		f := hclwrite.NewEmptyFile()
+		body := f.Body()
+		block := body.AppendNewBlock("resource", []string{"panop_zone", label})
+		block.Body().SetAttributeValue("zone_name", cty.StringVal(zone.ZoneName))
+		block.Body().SetAttributeValue("zone_type", cty.StringVal(zone.ZoneType))
+		setTagsAttribute(block.Body(), zone.Tags)
+		setMetadataAttributes(block.Body(), zone.Criticality, zone.OwnerEmail, zone.BusinessUnit, zone.Description)
+		if zone.PolicyId != 0 {
+			block.Body().SetAttributeValue("policy_id", cty.NumberIntVal(zone.PolicyId))
+		}
+		if zone.AutoDiscovery != nil {
+			block.Body().SetAttributeValue("auto_discovery", autoDiscoveryValue(zone.AutoDiscovery))
+		}
+		appendImportBlock(body, "panop_zone", label, zone.Id)
+
+		zoneFiles[zone.Id] = f
+		zoneOrder = append(zoneOrder, zone.Id)
+	}
+
+	var unzoned *hclwrite.File
+	for _, asset := range assets {
+		// Adopted assets are managed by panop_asset resources already.
+		if asset.DiscoverySource == discoverySourceDiscovered && !includeDiscovered {
+			continue
+		}
+		zoneLabel, ok := zoneLabels[asset.ZoneId]
+
+		var f *hclwrite.File
+		var label string
+		if ok {
+			f = zoneFiles[asset.ZoneId]
+			label = uniqueLabel(labels, zoneLabel+"_"+asset.AssetName)
+		} else {
+			if unzoned == nil {
+				unzoned = hclwrite.NewEmptyFile()
+			}
+			f = unzoned
+			label = uniqueLabel(labels, asset.AssetName)
+		}
+
+		resourceType := "panop_asset"
+		if isIPRangeAsset(asset) {
+			resourceType = "panop_ip_range"
+		}
+
+		body := f.Body()
+		body.AppendNewline()
+		block := body.AppendNewBlock("resource", []string{resourceType, label})
+		if resourceType == "panop_ip_range" {
+			block.Body().SetAttributeValue("cidr", cty.StringVal(asset.AssetName))
+		} else {
+			block.Body().SetAttributeValue("asset_name", cty.StringVal(asset.AssetName))
+			block.Body().SetAttributeValue("asset_type", cty.StringVal(asset.AssetType))
+		}
+		if ok {
+			block.Body().SetAttributeTraversal("zone_id", hcl.Traversal{
+				hcl.TraverseRoot{Name: "panop_zone"},
+				hcl.TraverseAttr{Name: zoneLabel},
+				hcl.TraverseAttr{Name: "id"},
+			})
+		} else {
+			block.Body().SetAttributeValue("zone_id", cty.NumberIntVal(asset.ZoneId))
+		}
+		setTagsAttribute(block.Body(), asset.Tags)
+		setMetadataAttributes(block.Body(), asset.Criticality, asset.OwnerEmail, asset.BusinessUnit, asset.Description)
+		if asset.MonitoringState == monitoringStatePaused {
+			block.Body().SetAttributeValue("monitoring_enabled", cty.False)
+			if asset.PausedUntil != "" {
+				block.Body().SetAttributeValue("pause_until", cty.StringVal(asset.PausedUntil))
+			}
+		}
+		appendImportBlock(body, resourceType, label, asset.AssetId)
+	}
+
+	for _, id := range zoneOrder {
+		files["zone_"+zoneLabels[id]+".tf"] = zoneFiles[id].Bytes()
+	}
+	if unzoned != nil {
+		files["assets_unzoned.tf"] = unzoned.Bytes()
+	}
+
+	return files
+}
+
+// isIPRangeAsset reports whether asset is a cidr asset holding nothing but
+// what panop_ip_range manages, as created by panop_ip_range.
+func isIPRangeAsset(asset assetResponse) bool {
+	return asset.AssetType == assetTypeCIDR &&
+		len(asset.Tags) == 0 &&
+		asset.Criticality == "" && asset.OwnerEmail == "" && asset.BusinessUnit == "" && asset.Description == "" &&
+		asset.MonitoringState != monitoringStatePaused
+}
+
+// setTagsAttribute sets the tags attribute of a resource block, unless
+// there are no tags.
+func setTagsAttribute(body *hclwrite.Body, tags map[string]string) {
+	if len(tags) == 0 {
+		return
+	}
+
+	values := make(map[string]cty.Value, len(tags))
+	for key, value := range tags {
+		values[key] = cty.StringVal(value)
+	}
+	body.SetAttributeValue("tags", cty.MapVal(values))
+}
+
+// setMetadataAttributes sets the business metadata attributes of a resource
+// block, leaving out the empty ones.
+func setMetadataAttributes(body *hclwrite.Body, criticality, ownerEmail, businessUnit, description string) {
+	for _, attribute := range []struct{ name, value string }{
+		{"criticality", criticality},
+		{"owner_email", ownerEmail},
+		{"business_unit", businessUnit},
+		{"description", description},
+	} {
+		if attribute.value != "" {
+			body.SetAttributeValue(attribute.name, cty.StringVal(attribute.value))
+		}
+	}
+}
+
+// autoDiscoveryValue returns the auto_discovery attribute value of Tower
+// discovery settings, leaving out the sources when every source is used.
+func autoDiscoveryValue(discovery *zoneAutoDiscovery) cty.Value {
+	attributes := map[string]cty.Value{
+		"enabled": cty.BoolVal(discovery.Enabled),
+		"depth":   cty.NumberIntVal(discovery.Depth),
+	}
+	if len(discovery.Sources) > 0 {
+		sources := make([]cty.Value, 0, len(discovery.Sources))
+		for _, source := range discovery.Sources {
+			sources = append(sources, cty.StringVal(source))
+		}
+		attributes["sources"] = cty.SetVal(sources)
+	}
+	return cty.ObjectVal(attributes)
+}
+
+// appendImportBlock appends an import block binding resourceType.label to
+// the Tower object id.
+func appendImportBlock(body *hclwrite.Body, resourceType, label string, id int64) {
+	body.AppendNewline()
+	block := body.AppendNewBlock("import", nil)
+	block.Body().SetAttributeTraversal("to", hcl.Traversal{
+		hcl.TraverseRoot{Name: resourceType},
+		hcl.TraverseAttr{Name: label},
+	})
+	block.Body().SetAttributeValue("id", cty.StringVal(strconv.FormatInt(id, 10)))
+}
+
+// uniqueLabel turns name into a valid Terraform identifier that is not
+// already in use, and records it.
+func uniqueLabel(used map[string]bool, name string) string {
+	var b strings.Builder
+	for _, c := range strings.ToLower(strings.TrimSuffix(name, ".")) {
+		switch {
+		case c >= 'a' && c <= 'z', c >= '0' && c <= '9', c == '_', c == '-':
+			b.WriteRune(c)
+		default:
+			b.WriteRune('_')
+		}
+	}
+	label := b.String()
+	if label == "" || (label[0] >= '0' && label[0] <= '9') || label[0] == '-' {
+		label = "_" + label
+	}
+
+	candidate := label
+	for i := 2; used[candidate]; i++ {
+		candidate = fmt.Sprintf("%s_%d", label, i)
+	}
+	used[candidate] = true
+	return candidate
+}
@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRenderExport(t *testing.T) {
+	zones := []zoneResponse{
+		{Id: 416, ZoneName: "example.com", ZoneType: "dns", Criticality: "high", PolicyId: 7,
+			AutoDiscovery: &zoneAutoDiscovery{Enabled: true, Depth: 3, Sources: []string{"passive_dns"}}},
+	}
+	assets := []assetResponse{
+		{AssetId: 12, AssetName: "www", AssetType: "dns", ZoneId: 416, Tags: map[string]string{"env": "prod"}},
+		{AssetId: 13, AssetName: "api", AssetType: "dns", ZoneId: 999},
+		{AssetId: 14, AssetName: "vpn", AssetType: "dns", ZoneId: 999, MonitoringState: "paused"},
+		{AssetId: 15, AssetName: "shop", AssetType: "dns", ZoneId: 416, DiscoverySource: "discovered"},
+		{AssetId: 16, AssetName: "192.0.2.0/24", AssetType: "cidr", ZoneId: 416},
+		{AssetId: 17, AssetName: "198.51.100.0/24", AssetType: "cidr", ZoneId: 416, Criticality: "high"},
+		{AssetId: 18, AssetName: "mail", AssetType: "dns", ZoneId: 416, DiscoverySource: "adopted"},
+	}
+
+	files := renderExport(zones, assets, false)
+	if len(files) != 2 {
+		t.Fatalf("expected 2 files, got %d", len(files))
+	}
+
+	zoneFile := string(files["zone_example_com.tf"])
+	for _, want := range []string{
+		`resource "panop_zone" "example_com" {`,
+		`zone_name   = "example.com"`,
+		`criticality = "high"`,
+		`policy_id   = 7`,
+		`sources = ["passive_dns"]`,
+		`to = panop_zone.example_com`,
+		`id = "416"`,
+		`resource "panop_asset" "example_com_www" {`,
+		`zone_id    = panop_zone.example_com.id`,
+		`to = panop_asset.example_com_www`,
+		`env = "prod"`,
+		`id = "12"`,
+		`resource "panop_ip_range" "example_com_192_0_2_0_24" {`,
+		`cidr    = "192.0.2.0/24"`,
+		`to = panop_ip_range.example_com_192_0_2_0_24`,
+		`resource "panop_asset" "example_com_198_51_100_0_24" {`,
+		`resource "panop_asset" "example_com_mail" {`,
+	} {
+		if !strings.Contains(zoneFile, want) {
+			t.Errorf("zone_example_com.tf does not contain %q:\n%s", want, zoneFile)
+		}
+	}
+
+	if strings.Contains(zoneFile, `"shop"`) {
+		t.Errorf("zone_example_com.tf contains the discovered asset:\n%s", zoneFile)
+	}
+	if !strings.Contains(string(renderExport(zones, assets, true)["zone_example_com.tf"]), `resource "panop_asset" "example_com_shop" {`) {
+		t.Errorf("zone_example_com.tf does not contain the discovered asset when asked to")
+	}
+
+	unzoned := string(files["assets_unzoned.tf"])
+	if !strings.Contains(unzoned, `zone_id    = 999`) {
+		t.Errorf("assets_unzoned.tf does not contain a literal zone_id:\n%s", unzoned)
+	}
+	if !strings.Contains(unzoned, `monitoring_enabled = false`) {
+		t.Errorf("assets_unzoned.tf does not pause the monitoring of the asset:\n%s", unzoned)
+	}
+}
+
+func TestUniqueLabel(t *testing.T) {
+	used := map[string]bool{}
+	for _, tc := range []struct{ name, want string }{
+		{"Example.COM.", "example_com"},
+		{"example.com", "example_com_2"},
+		{"1password.com", "_1password_com"},
+		{"*.apps", "__apps"},
+	} {
+		if got := uniqueLabel(used, tc.name); got != tc.want {
+			t.Errorf("uniqueLabel(%q) = %q, want %q", tc.name, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"slices"
+	"strings"
+	"time"
+)
+
+// severities lists the finding severities from the lowest to the highest.
+var severities = []string{"info", "low", "medium", "high", "critical"}
+
+// findingStatuses lists the finding statuses reported by Tower.
+var findingStatuses = []string{"open", "accepted", "false_positive", "resolved"}
+
+// normalizeSeverity returns the severity of severities matching severity
+// regardless of case. Unknown severities are taken as critical, so that a
+// severity Tower adds later fails the posture rather than passing it.
+func normalizeSeverity(severity string) string {
+	severity = strings.ToLower(strings.TrimSpace(severity))
+	if !slices.Contains(severities, severity) {
+		return "critical"
+	}
+	return severity
+}
+
+// severityAtLeast reports whether severity is minSeverity or higher, see
+// normalizeSeverity.
+func severityAtLeast(severity, minSeverity string) bool {
+	return slices.Index(severities, normalizeSeverity(severity)) >= slices.Index(severities, normalizeSeverity(minSeverity))
+}
+
+// findingsFilter selects findings, zero fields select every finding.
+type findingsFilter struct {
+	ZoneId      int64
+	AssetId     int64
+	MinSeverity string
+	Status      string
+
+	// MinAge selects findings first seen at least MinAge ago.
+	MinAge time.Duration
+}
+
+// match reports whether the finding is selected at now. Findings without
+// a valid first_seen are taken as old enough for a MinAge filter.
+func (f findingsFilter) match(finding findingResponse, now time.Time) bool {
+	if f.ZoneId != 0 && finding.ZoneId != f.ZoneId {
+		return false
+	}
+	if f.AssetId != 0 && finding.AssetId != f.AssetId {
+		return false
+	}
+	if f.MinSeverity != "" && !severityAtLeast(finding.Severity, f.MinSeverity) {
+		return false
+	}
+	if f.Status != "" && finding.Status != f.Status {
+		return false
+	}
+	if f.MinAge > 0 {
+		firstSeen, err := time.Parse(time.RFC3339, finding.FirstSeen)
+		if err == nil && now.Sub(firstSeen) < f.MinAge {
+			return false
+		}
+	}
+	return true
+}
+
+// filterFindings returns the findings selected by filter at now.
+func filterFindings(findings []findingResponse, filter findingsFilter, now time.Time) []findingResponse {
+	var selected []findingResponse
+	for _, finding := range findings {
+		if filter.match(finding, now) {
+			selected = append(selected, finding)
+		}
+	}
+	return selected
+}
+
+// countBySeverity counts the findings of each severity, every severity is
+// counted even when it has no finding. Severities are normalized by
+// normalizeSeverity.
+func countBySeverity(findings []findingResponse) map[string]int64 {
+	counts := make(map[string]int64, len(severities))
+	for _, severity := range severities {
+		counts[severity] = 0
+	}
+	for _, finding := range findings {
+		counts[normalizeSeverity(finding.Severity)]++
+	}
+	return counts
+}
+
+// postureFindings returns the open findings selected by scope at now, and
+// those of them failing the posture: reaching threshold and first seen at
+// least maxAge ago. Accepted, false positive and resolved findings never
+// fail the posture.
+func postureFindings(findings []findingResponse, scope findingsFilter, threshold string, maxAge time.Duration, now time.Time) (open, failing []findingResponse) {
+	scope.Status = "open"
+	open = filterFindings(findings, scope, now)
+	failing = filterFindings(open, findingsFilter{
+		MinSeverity: threshold,
+		MinAge:      maxAge,
+	}, now)
+	return open, failing
+}
@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"maps"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestSeverityAtLeast(t *testing.T) {
+	for _, tc := range []struct {
+		severity, minSeverity string
+		want                  bool
+	}{
+		{"critical", "high", true},
+		{"high", "high", true},
+		{"medium", "high", false},
+		{"info", "info", true},
+		{"CRITICAL", "high", true},
+		{" High ", "high", true},
+		{"unknown", "critical", true},
+	} {
+		if got := severityAtLeast(tc.severity, tc.minSeverity); got != tc.want {
+			t.Errorf("severityAtLeast(%q, %q) = %t, expected %t", tc.severity, tc.minSeverity, got, tc.want)
+		}
+	}
+}
+
+func TestFilterFindings(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	findings := []findingResponse{
+		{Id: 1, Severity: "critical", Status: "open", ZoneId: 416, AssetId: 12, FirstSeen: "2026-01-01T00:00:00Z"},
+		{Id: 2, Severity: "medium", Status: "open", ZoneId: 416, AssetId: 13, FirstSeen: "2026-02-28T00:00:00Z"},
+		{Id: 3, Severity: "high", Status: "resolved", ZoneId: 417, AssetId: 14, FirstSeen: "2026-02-01T00:00:00Z"},
+		{Id: 4, Severity: "high", Status: "open", ZoneId: 416, AssetId: 12},
+	}
+
+	for _, tc := range []struct {
+		name   string
+		filter findingsFilter
+		want   []int64
+	}{
+		{"no filter", findingsFilter{}, []int64{1, 2, 3, 4}},
+		{"zone", findingsFilter{ZoneId: 416}, []int64{1, 2, 4}},
+		{"asset", findingsFilter{AssetId: 12}, []int64{1, 4}},
+		{"min severity", findingsFilter{MinSeverity: "high"}, []int64{1, 3, 4}},
+		{"status", findingsFilter{Status: "open", ZoneId: 416, MinSeverity: "medium"}, []int64{1, 2, 4}},
+		{"min age", findingsFilter{MinAge: 7 * 24 * time.Hour}, []int64{1, 3, 4}},
+	} {
+		var got []int64
+		for _, finding := range filterFindings(findings, tc.filter, now) {
+			got = append(got, finding.Id)
+		}
+		if !slices.Equal(got, tc.want) {
+			t.Errorf("%s: expected findings %v, got %v", tc.name, tc.want, got)
+		}
+	}
+}
+
+func TestCountBySeverity(t *testing.T) {
+	counts := countBySeverity([]findingResponse{
+		{Severity: "critical"}, {Severity: "high"}, {Severity: "critical"}, {Severity: "unknown"}, {Severity: "LOW"},
+	})
+
+	want := map[string]int64{"info": 0, "low": 1, "medium": 0, "high": 1, "critical": 3}
+	if !maps.Equal(counts, want) {
+		t.Errorf("expected counts %v, got %v", want, counts)
+	}
+}
+
+func TestPostureFindings(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	findings := []findingResponse{
+		{Id: 1, Severity: "high", Status: "open", ZoneId: 416, FirstSeen: "2026-01-01T00:00:00Z"},
+		{Id: 2, Severity: "high", Status: "open", ZoneId: 416, FirstSeen: "2026-02-28T00:00:00Z"},
+		{Id: 3, Severity: "critical", Status: "accepted", ZoneId: 416, FirstSeen: "2026-01-01T00:00:00Z"},
+		{Id: 4, Severity: "medium", Status: "open", ZoneId: 416, FirstSeen: "2026-01-01T00:00:00Z"},
+		{Id: 5, Severity: "CRITICAL", Status: "open", ZoneId: 417, FirstSeen: "2026-02-28T00:00:00Z"},
+		{Id: 6, Severity: "high", Status: "open", ZoneId: 417, FirstSeen: "yesterday"},
+		{Id: 7, Severity: "severe", Status: "open", ZoneId: 418},
+	}
+
+	for _, tc := range []struct {
+		name      string
+		scope     findingsFilter
+		threshold string
+		maxAge    time.Duration
+		wantPass  bool
+		want      []int64
+	}{
+		{"every open finding", findingsFilter{}, "high", 0, false, []int64{1, 2, 5, 6, 7}},
+		{"tolerated by age", findingsFilter{ZoneId: 416}, "high", 7 * 24 * time.Hour, false, []int64{1}},
+		{"below threshold", findingsFilter{ZoneId: 416}, "critical", 0, true, nil},
+		{"uppercase severity", findingsFilter{ZoneId: 417}, "critical", 0, false, []int64{5}},
+		{"unparsable first seen", findingsFilter{ZoneId: 417}, "high", 7 * 24 * time.Hour, false, []int64{6}},
+		{"unknown severity without first seen", findingsFilter{ZoneId: 418}, "critical", 7 * 24 * time.Hour, false, []int64{7}},
+	} {
+		_, failing := postureFindings(findings, tc.scope, tc.threshold, tc.maxAge, now)
+		var got []int64
+		for _, finding := range failing {
+			got = append(got, finding.Id)
+		}
+		if pass := len(failing) == 0; pass != tc.wantPass || !slices.Equal(got, tc.want) {
+			t.Errorf("%s: expected pass %t with failing findings %v, got %t with %v", tc.name, tc.wantPass, tc.want, pass, got)
+		}
+	}
+}
@@ -0,0 +1,269 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// sameName reports whether two zone or asset names designate the same
+// object, see normalizeAssetName.
+func sameName(a, b string) bool {
+	return normalizeAssetName(a) == normalizeAssetName(b)
+}
+
+// qualifyAssetName returns name fully qualified within zoneName, single
+// label names being relative to the zone as in assetInZone.
+func qualifyAssetName(name, zoneName string) string {
+	if strings.Contains(normalizeAssetName(name), ".") || strings.Contains(name, ":") {
+		return name
+	}
+	return strings.TrimSuffix(name, ".") + "." + zoneName
+}
+
+// sameAssetName reports whether two asset names of the zone zoneName
+// designate the same asset, a relative name and its fully qualified form
+// included.
+func sameAssetName(a, b, zoneName string) bool {
+	return sameName(qualifyAssetName(a, zoneName), qualifyAssetName(b, zoneName))
+}
+
+// checkZoneExists reports a "Zone Not Found" error when Tower does not know
+// the zone id, so that importing a wrong id fails instead of leaving an
+// empty resource in the state.
+func checkZoneExists(ctx context.Context, client clientObj, id int64) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	zones, err := client.listZones(ctx)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list zones, got error: %s", err))
+		return diags
+	}
+	for _, zone := range zones {
+		if zone.Id == id {
+			return diags
+		}
+	}
+	diags.AddError("Zone Not Found", fmt.Sprintf("No zone with id %d was found.", id))
+	return diags
+}
+
+// checkAssetExists reports an "Asset Not Found" error when Tower does not
+// know the asset id, see checkZoneExists.
+func checkAssetExists(ctx context.Context, client clientObj, id int64) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	_, found, err := client.findAsset(ctx, id)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list assets, got error: %s", err))
+		return diags
+	}
+	if !found {
+		diags.AddError("Asset Not Found", fmt.Sprintf("No asset with id %d was found.", id))
+	}
+	return diags
+}
+
+// resolveZoneImportID turns a zone import id, either numeric or a zone
+// name, into the zone id known by Tower.
+func resolveZoneImportID(ctx context.Context, client clientObj, importID string) (int64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if id, err := strconv.ParseInt(importID, 10, 64); err == nil {
+		return id, checkZoneExists(ctx, client, id)
+	}
+
+	if importID == "" {
+		diags.AddError("Invalid Import ID", "Expected a numeric zone id or a zone name, got an empty string.")
+		return 0, diags
+	}
+
+	zones, err := client.listZones(ctx)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list zones, got error: %s", err))
+		return 0, diags
+	}
+
+	zone, diags := findZoneByName(zones, importID)
+	if diags.HasError() {
+		return 0, diags
+	}
+	return zone.Id, diags
+}
+
+// resolveAssetImportID turns an asset import id, either numeric or of the
+// form "zone_name/asset_name", into the asset id known by Tower.
+func resolveAssetImportID(ctx context.Context, client clientObj, importID string) (int64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if id, err := strconv.ParseInt(importID, 10, 64); err == nil {
+		return id, checkAssetExists(ctx, client, id)
+	}
+
+	zoneName, assetName, ok := strings.Cut(importID, "/")
+	if !ok || zoneName == "" || assetName == "" {
+		diags.AddError("Invalid Import ID",
+			fmt.Sprintf("Expected a numeric asset id or an id of the form zone_name/asset_name, got: %q", importID))
+		return 0, diags
+	}
+
+	zones, err := client.listZones(ctx)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list zones, got error: %s", err))
+		return 0, diags
+	}
+
+	zone, diags := findZoneByName(zones, zoneName)
+	if diags.HasError() {
+		return 0, diags
+	}
+
+	assets, err := client.listAssets(ctx)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list assets, got error: %s", err))
+		return 0, diags
+	}
+
+	asset, diags := findAssetByName(assets, zone, assetName)
+	if diags.HasError() {
+		return 0, diags
+	}
+	return asset.AssetId, diags
+}
+
+// resolveZoneIdentity turns the identity of an import block into the zone
+// id known by Tower. The identity must hold either the zone id or the zone
+// name, the tenant id is optional.
+func resolveZoneIdentity(ctx context.Context, client clientObj, identity ZoneIdentityModel) (int64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !identity.Id.IsNull() {
+		return identity.Id.ValueInt64(), checkZoneExists(ctx, client, identity.Id.ValueInt64())
+	}
+
+	if identity.ZoneName.IsNull() {
+		diags.AddError("Invalid Import Identity", "Expected the identity to hold either id or zone_name.")
+		return 0, diags
+	}
+
+	zones, err := client.listZones(ctx)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list zones, got error: %s", err))
+		return 0, diags
+	}
+
+	if !identity.TenantId.IsNull() {
+		zones = filterZonesByTenant(zones, identity.TenantId.ValueInt64())
+	}
+
+	zone, diags := findZoneByName(zones, identity.ZoneName.ValueString())
+	if diags.HasError() {
+		return 0, diags
+	}
+	return zone.Id, diags
+}
+
+// resolveAssetIdentity turns the identity of an import block into the asset
+// id known by Tower. The identity must hold either the asset id or both the
+// zone id and the asset name.
+func resolveAssetIdentity(ctx context.Context, client clientObj, identity AssetIdentityModel) (int64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !identity.Id.IsNull() {
+		return identity.Id.ValueInt64(), checkAssetExists(ctx, client, identity.Id.ValueInt64())
+	}
+
+	if identity.ZoneId.IsNull() || identity.AssetName.IsNull() {
+		diags.AddError("Invalid Import Identity", "Expected the identity to hold either id or both zone_id and asset_name.")
+		return 0, diags
+	}
+
+	assets, err := client.listAssets(ctx)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list assets, got error: %s", err))
+		return 0, diags
+	}
+
+	zone := zoneResponse{Id: identity.ZoneId.ValueInt64(), ZoneName: strconv.FormatInt(identity.ZoneId.ValueInt64(), 10)}
+	asset, diags := findAssetByName(assets, zone, identity.AssetName.ValueString())
+	if diags.HasError() {
+		return 0, diags
+	}
+	return asset.AssetId, diags
+}
+
+// findAssetByName returns the single asset named name in zone.
+func findAssetByName(assets []assetResponse, zone zoneResponse, name string) (assetResponse, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var matches []assetResponse
+	for _, asset := range assets {
+		if asset.ZoneId == zone.Id && sameAssetName(asset.AssetName, name, zone.ZoneName) {
+			matches = append(matches, asset)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		diags.AddError("Asset Not Found",
+			fmt.Sprintf("No asset named %q was found in zone %q (id %d).", name, zone.ZoneName, zone.Id))
+		return assetResponse{}, diags
+	case 1:
+		return matches[0], diags
+	default:
+		ids := make([]string, 0, len(matches))
+		for _, asset := range matches {
+			ids = append(ids, strconv.FormatInt(asset.AssetId, 10))
+		}
+		diags.AddError("Ambiguous Import ID",
+			fmt.Sprintf("%d assets named %q were found in zone %q (ids %s), import by numeric id instead.",
+				len(matches), name, zone.ZoneName, strings.Join(ids, ", ")))
+		return assetResponse{}, diags
+	}
+}
+
+// filterZonesByTenant returns the zones belonging to tenantId.
+func filterZonesByTenant(zones []zoneResponse, tenantId int64) []zoneResponse {
+	var filtered []zoneResponse
+	for _, zone := range zones {
+		if zone.TenantId == tenantId {
+			filtered = append(filtered, zone)
+		}
+	}
+	return filtered
+}
+
+// findZoneByName returns the single zone named name.
+func findZoneByName(zones []zoneResponse, name string) (zoneResponse, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var matches []zoneResponse
+	for _, zone := range zones {
+		if sameName(zone.ZoneName, name) {
+			matches = append(matches, zone)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		diags.AddError("Zone Not Found", fmt.Sprintf("No zone named %q was found.", name))
+		return zoneResponse{}, diags
+	case 1:
+		return matches[0], diags
+	default:
+		ids := make([]string, 0, len(matches))
+		for _, zone := range matches {
+			ids = append(ids, strconv.FormatInt(zone.Id, 10))
+		}
+		diags.AddError("Ambiguous Import ID",
+			fmt.Sprintf("%d zones named %q were found (ids %s), import by numeric id instead.",
+				len(matches), name, strings.Join(ids, ", ")))
+		return zoneResponse{}, diags
+	}
+}
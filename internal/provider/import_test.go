@@ -0,0 +1,277 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testResponse is a Tower stub response with another status than 200 OK.
+type testResponse struct {
+	status int
+	body   any
+}
+
+// newTestClient returns a client of a Tower stub answering each request
+// with the JSON encoding of its responses entry, keyed by "METHOD path" or
+// by path for any method, and 404 for any other request. testResponse
+// entries set the response status.
+func newTestClient(t *testing.T, responses map[string]any) clientObj {
+	t.Helper()
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		response, ok := responses[r.Method+" "+r.URL.Path]
+		if !ok {
+			response, ok = responses[r.URL.Path]
+		}
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if response, ok := response.(testResponse); ok {
+			w.WriteHeader(response.status)
+			_ = json.NewEncoder(w).Encode(response.body)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+	t.Cleanup(server.Close)
+
+	return clientObj{
+		clientHttp: server.Client(),
+		host:       server.Listener.Addr().String(),
+	}
+}
+
+// newTestState returns the state of res holding only the id attribute.
+func newTestState(t *testing.T, res resource.Resource, id int64) tfsdk.State {
+	t.Helper()
+
+	ctx := context.Background()
+	schemaResp := resource.SchemaResponse{}
+	res.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	if diags := state.SetAttribute(ctx, path.Root("id"), id); diags.HasError() {
+		t.Fatalf("unable to set the id: %v", diags)
+	}
+	return state
+}
+
+// newTestIdentity returns the empty identity of res.
+func newTestIdentity(t *testing.T, res resource.ResourceWithIdentity) *tfsdk.ResourceIdentity {
+	t.Helper()
+
+	ctx := context.Background()
+	schemaResp := resource.IdentitySchemaResponse{}
+	res.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &schemaResp)
+
+	return &tfsdk.ResourceIdentity{
+		Schema: schemaResp.IdentitySchema,
+		Raw:    tftypes.NewValue(schemaResp.IdentitySchema.Type().TerraformType(ctx), nil),
+	}
+}
+
+func TestResolveImportIDNotFound(t *testing.T) {
+	client := newTestClient(t, map[string]any{
+		"/api/zones":  []zoneResponse{{Id: 1, ZoneName: "example.com"}},
+		"/api/assets": []assetResponse{{AssetId: 2, ZoneId: 1, AssetName: "www.example.com"}},
+	})
+	ctx := context.Background()
+
+	if id, diags := resolveZoneImportID(ctx, client, "1"); diags.HasError() || id != 1 {
+		t.Errorf("resolveZoneImportID(1) = %d, %v", id, diags)
+	}
+	if _, diags := resolveZoneImportID(ctx, client, "42"); !diags.HasError() || diags[0].Summary() != "Zone Not Found" {
+		t.Errorf("resolveZoneImportID(42) = %v, want a Zone Not Found error", diags)
+	}
+
+	if id, diags := resolveAssetImportID(ctx, client, "2"); diags.HasError() || id != 2 {
+		t.Errorf("resolveAssetImportID(2) = %d, %v", id, diags)
+	}
+	if _, diags := resolveAssetImportID(ctx, client, "42"); !diags.HasError() || diags[0].Summary() != "Asset Not Found" {
+		t.Errorf("resolveAssetImportID(42) = %v, want an Asset Not Found error", diags)
+	}
+}
+
+func TestReadRemovesMissingResource(t *testing.T) {
+	client := newTestClient(t, map[string]any{
+		"/api/zones":  []zoneResponse{},
+		"/api/assets": []assetResponse{},
+	})
+	ctx := context.Background()
+
+	for name, res := range map[string]resource.Resource{
+		"zone":              &PanopZoneResource{clientObj: client},
+		"asset":             &PanopAssetResource{clientObj: client},
+		"ip range":          &PanopIPRangeResource{clientObj: client},
+		"scan":              &PanopScanResource{clientObj: client},
+		"scan policy":       &PanopScanPolicyResource{clientObj: client},
+		"finding exception": &PanopFindingExceptionResource{clientObj: client},
+		"scope exclusion":   &PanopScopeExclusionResource{clientObj: client},
+		"discovery seed":    &PanopDiscoverySeedResource{clientObj: client},
+	} {
+		t.Run(name, func(t *testing.T) {
+			state := newTestState(t, res, 42)
+			resp := resource.ReadResponse{State: state}
+			res.Read(ctx, resource.ReadRequest{State: state}, &resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Read: %v", resp.Diagnostics)
+			}
+			if !resp.State.Raw.IsNull() {
+				t.Errorf("Read kept the state of the missing %s", name)
+			}
+		})
+	}
+}
+
+func TestAssetCreateTracksAssetWhenPauseFails(t *testing.T) {
+	res := &PanopAssetResource{clientObj: newTestClient(t, map[string]any{
+		"POST /api/assets": testResponse{http.StatusCreated, assetCreateResponse{AssetId: 12, AssetName: "www.example.com"}},
+	})}
+	plan := newTestPlan(t, res, map[string]any{
+		"asset_name":         "www.example.com",
+		"asset_type":         "dns",
+		"zone_id":            int64(1),
+		"monitoring_enabled": false,
+	})
+
+	ctx := context.Background()
+	resp := resource.CreateResponse{
+		State:    tfsdk.State{Schema: plan.Schema, Raw: tftypes.NewValue(plan.Raw.Type(), nil)},
+		Identity: newTestIdentity(t, res),
+	}
+	res.Create(ctx, resource.CreateRequest{Plan: plan}, &resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatalf("expected the pause error, got %v", resp.Diagnostics)
+	}
+	var id types.Int64
+	resp.State.GetAttribute(ctx, path.Root("id"), &id)
+	if id.ValueInt64() != 12 {
+		t.Errorf("expected the created asset 12 in state, got %s", id)
+	}
+}
+
+func TestAssetAdoptsDiscoveredAsset(t *testing.T) {
+	res := &PanopAssetResource{clientObj: newTestClient(t, map[string]any{
+		"POST /api/assets/12/adopt": struct{}{},
+	})}
+	attributes := map[string]any{
+		"id":                 int64(12),
+		"asset_name":         "www.example.com",
+		"asset_type":         "dns",
+		"zone_id":            int64(1),
+		"monitoring_enabled": true,
+		"discovery_source":   "discovered",
+	}
+	prior := newTestPlan(t, res, attributes)
+	state := tfsdk.State{Schema: prior.Schema, Raw: prior.Raw}
+	ctx := context.Background()
+
+	planResp := resource.ModifyPlanResponse{Plan: prior}
+	res.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: prior, State: state}, &planResp)
+	var source types.String
+	planResp.Plan.GetAttribute(ctx, path.Root("discovery_source"), &source)
+	if planResp.Diagnostics.HasError() || !source.IsUnknown() {
+		t.Fatalf("expected an unknown discovery_source in the plan, got %s %v", source, planResp.Diagnostics)
+	}
+
+	resp := resource.UpdateResponse{State: state}
+	res.Update(ctx, resource.UpdateRequest{Plan: planResp.Plan, State: state}, &resp)
+	resp.State.GetAttribute(ctx, path.Root("discovery_source"), &source)
+	if resp.Diagnostics.HasError() || source.ValueString() != discoverySourceAdopted {
+		t.Errorf("expected the asset to be adopted, got %s %v", source, resp.Diagnostics)
+	}
+}
+
+func TestDeleteIgnoresMissingResource(t *testing.T) {
+	client := newTestClient(t, nil)
+	ctx := context.Background()
+
+	for name, res := range map[string]resource.Resource{
+		"scan":              &PanopScanResource{clientObj: client},
+		"scan policy":       &PanopScanPolicyResource{clientObj: client},
+		"finding exception": &PanopFindingExceptionResource{clientObj: client},
+		"scope exclusion":   &PanopScopeExclusionResource{clientObj: client},
+		"discovery seed":    &PanopDiscoverySeedResource{clientObj: client},
+	} {
+		t.Run(name, func(t *testing.T) {
+			state := newTestState(t, res, 42)
+			resp := resource.DeleteResponse{State: state}
+			res.Delete(ctx, resource.DeleteRequest{State: state}, &resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Errorf("Delete of a missing %s: %v", name, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestFindAssetByNameRelative(t *testing.T) {
+	zone := zoneResponse{Id: 1, ZoneName: "example.com"}
+	assets := []assetResponse{
+		{AssetId: 2, ZoneId: 1, AssetName: "www"},
+		{AssetId: 3, ZoneId: 1, AssetName: "mail.example.com"},
+		{AssetId: 4, ZoneId: 1, AssetName: "2001:db8::1", AssetType: "ip"},
+	}
+
+	for _, tc := range []struct {
+		name string
+		want int64
+	}{
+		{"www", 2},
+		{"www.example.com", 2},
+		{"WWW.example.com.", 2},
+		{"mail", 3},
+		{"mail.example.com", 3},
+		{"2001:DB8::1", 4},
+	} {
+		asset, diags := findAssetByName(assets, zone, tc.name)
+		if diags.HasError() || asset.AssetId != tc.want {
+			t.Errorf("findAssetByName(%q) = %d, %v, want %d", tc.name, asset.AssetId, diags, tc.want)
+		}
+	}
+
+	if _, diags := findAssetByName(assets, zone, "www.other.com"); !diags.HasError() {
+		t.Errorf("findAssetByName(www.other.com) found an asset")
+	}
+}
+
+func TestIPRangeRejectsOtherAssetTypes(t *testing.T) {
+	res := &PanopIPRangeResource{clientObj: newTestClient(t, map[string]any{
+		"/api/assets": []assetResponse{{AssetId: 42, ZoneId: 1, AssetName: "www.example.com", AssetType: "dns"}},
+	})}
+	ctx := context.Background()
+
+	state := newTestState(t, res, 42)
+	readResp := resource.ReadResponse{State: state}
+	res.Read(ctx, resource.ReadRequest{State: state}, &readResp)
+	if !readResp.Diagnostics.HasError() || readResp.Diagnostics[0].Summary() != "Not An IP Range" {
+		t.Errorf("Read of a dns asset: expected a Not An IP Range error, got %v", readResp.Diagnostics)
+	}
+
+	importResp := resource.ImportStateResponse{
+		State: tfsdk.State{Schema: state.Schema, Raw: tftypes.NewValue(state.Raw.Type(), nil)},
+	}
+	res.ImportState(ctx, resource.ImportStateRequest{ID: "42"}, &importResp)
+	if !importResp.Diagnostics.HasError() || importResp.Diagnostics[0].Summary() != "Not An IP Range" {
+		t.Errorf("import of a dns asset: expected a Not An IP Range error, got %v", importResp.Diagnostics)
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ list.ListResource = &PanopAssetListResource{}
+var _ list.ListResourceWithConfigure = &PanopAssetListResource{}
+
+func NewPanopAssetListResource() list.ListResource {
+	return &PanopAssetListResource{}
+}
+
+// PanopAssetListResource defines the list resource implementation.
+type PanopAssetListResource struct {
+	clientObj
+}
+
+// AssetListModel describes the list resource config data model.
+type AssetListModel struct {
+	ZoneId    types.Int64  `tfsdk:"zone_id"`
+	AssetName types.String `tfsdk:"asset_name"`
+	AssetType types.String `tfsdk:"asset_type"`
+}
+
+func (r *PanopAssetListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_asset"
+}
+
+func (r *PanopAssetListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the assets of the tenant.",
+
+		Attributes: map[string]schema.Attribute{
+			"zone_id": schema.Int64Attribute{
+				MarkdownDescription: "Only list assets of this zone",
+				Optional:            true,
+			},
+			"asset_name": schema.StringAttribute{
+				MarkdownDescription: "Only list assets whose name contains this value, case-insensitive",
+				Optional:            true,
+			},
+			"asset_type": schema.StringAttribute{
+				MarkdownDescription: "Only list assets of this type",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *PanopAssetListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(clientObj)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected clientObj, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	r.clientObj = client
+}
+
+func (r *PanopAssetListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data AssetListModel
+
+	// Read Terraform list config data into the model
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	assets, err := r.listAssets(ctx)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list assets, got error: %s", err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		var count int64
+		for _, asset := range assets {
+			if !data.ZoneId.IsNull() && asset.ZoneId != data.ZoneId.ValueInt64() {
+				continue
+			}
+			if !data.AssetType.IsNull() && asset.AssetType != data.AssetType.ValueString() {
+				continue
+			}
+			if !data.AssetName.IsNull() && !strings.Contains(strings.ToLower(asset.AssetName), strings.ToLower(data.AssetName.ValueString())) {
+				continue
+			}
+			if req.Limit > 0 && count >= req.Limit {
+				return
+			}
+			count++
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = asset.AssetName
+			result.Diagnostics.Append(result.Identity.Set(ctx, newAssetIdentityModel(asset))...)
+			if req.IncludeResource {
+				model := newAssetResourceModel(asset)
+				model.Tags, model.TagsAll = readTags(types.MapNull(types.StringType), asset.Tags, r.defaultTags)
+				result.Diagnostics.Append(result.Resource.Set(ctx, model)...)
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
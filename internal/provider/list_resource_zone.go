@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ list.ListResource = &PanopZoneListResource{}
+var _ list.ListResourceWithConfigure = &PanopZoneListResource{}
+
+func NewPanopZoneListResource() list.ListResource {
+	return &PanopZoneListResource{}
+}
+
+// PanopZoneListResource defines the list resource implementation.
+type PanopZoneListResource struct {
+	clientObj
+}
+
+// ZoneListModel describes the list resource config data model.
+type ZoneListModel struct {
+	ZoneName types.String `tfsdk:"zone_name"`
+	ZoneType types.String `tfsdk:"zone_type"`
+}
+
+func (r *PanopZoneListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_zone"
+}
+
+func (r *PanopZoneListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the zones of the tenant.",
+
+		Attributes: map[string]schema.Attribute{
+			"zone_name": schema.StringAttribute{
+				MarkdownDescription: "Only list zones whose name contains this value, case-insensitive",
+				Optional:            true,
+			},
+			"zone_type": schema.StringAttribute{
+				MarkdownDescription: "Only list zones of this type",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *PanopZoneListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(clientObj)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected clientObj, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	r.clientObj = client
+}
+
+func (r *PanopZoneListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data ZoneListModel
+
+	// Read Terraform list config data into the model
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	zones, err := r.listZones(ctx)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list zones, got error: %s", err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		var count int64
+		for _, zone := range zones {
+			if !data.ZoneType.IsNull() && zone.ZoneType != data.ZoneType.ValueString() {
+				continue
+			}
+			if !data.ZoneName.IsNull() && !strings.Contains(strings.ToLower(zone.ZoneName), strings.ToLower(data.ZoneName.ValueString())) {
+				continue
+			}
+			if req.Limit > 0 && count >= req.Limit {
+				return
+			}
+			count++
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = zone.ZoneName
+			result.Diagnostics.Append(result.Identity.Set(ctx, newZoneIdentityModel(zone))...)
+			if req.IncludeResource {
+				model := newZoneResourceModel(zone)
+				model.Tags, model.TagsAll = readTags(types.MapNull(types.StringType), zone.Tags, r.defaultTags)
+				result.Diagnostics.Append(result.Resource.Set(ctx, model)...)
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,26 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// optionalString returns a string attribute holding s. Tower returns an
+// empty string for unset business metadata, it is mapped to null.
+func optionalString(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(s)
+}
+
+// optionalInt64 returns an int64 attribute holding i. Tower returns 0 for
+// unset references such as the scan policy of a zone, it is mapped to null.
+func optionalInt64(i int64) types.Int64 {
+	if i == 0 {
+		return types.Int64Null()
+	}
+	return types.Int64Value(i)
+}
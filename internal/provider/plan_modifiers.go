@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// domainNameRequiresReplace returns a plan modifier requiring the resource
+// to be replaced when a domain name attribute designates another name.
+// Changes of case, trailing dot or IDN form are planned in place.
+func domainNameRequiresReplace() planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace = normalizeDomainName(req.StateValue.ValueString()) != normalizeDomainName(req.PlanValue.ValueString())
+		},
+		"If the value of this attribute designates another domain name, Terraform will destroy and recreate the resource.",
+		"If the value of this attribute designates another domain name, Terraform will destroy and recreate the resource.",
+	)
+}
+
+// assetNameRequiresReplace returns a plan modifier requiring the resource
+// to be replaced when an asset name attribute designates another asset,
+// see normalizeAssetName.
+func assetNameRequiresReplace() planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace = normalizeAssetName(req.StateValue.ValueString()) != normalizeAssetName(req.PlanValue.ValueString())
+		},
+		"If the value of this attribute designates another asset, Terraform will destroy and recreate the resource.",
+		"If the value of this attribute designates another asset, Terraform will destroy and recreate the resource.",
+	)
+}
+
+var _ planmodifier.Bool = activeUntilExpiryChangeModifier{}
+
+// activeUntilExpiryChangeModifier plans a computed active attribute with
+// its prior value, unless the resource is created or its expires_at
+// changes. Tower decides on its own clock whether the resource expired, so
+// the value is then left unknown for Tower to report.
+type activeUntilExpiryChangeModifier struct{}
+
+func (m activeUntilExpiryChangeModifier) Description(ctx context.Context) string {
+	return "The value of this attribute is unknown until applied when expires_at changes, and kept otherwise."
+}
+
+func (m activeUntilExpiryChangeModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m activeUntilExpiryChangeModifier) PlanModifyBool(ctx context.Context, req planmodifier.BoolRequest, resp *planmodifier.BoolResponse) {
+	// Nothing to keep on create or destroy.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() || req.StateValue.IsNull() || !req.PlanValue.IsUnknown() {
+		return
+	}
+
+	var prior, planned types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("expires_at"), &prior)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("expires_at"), &planned)...)
+
+	if resp.Diagnostics.HasError() || !prior.Equal(planned) {
+		return
+	}
+	resp.PlanValue = req.StateValue
+}
+
+// attributeChange describes the planned change of an attribute.
+type attributeChange struct {
+	name           string
+	prior, planned attr.Value
+}
+
+func (c attributeChange) String() string {
+	if c.planned.IsUnknown() {
+		return fmt.Sprintf("%s changes from %s to a value known after apply", c.name, c.prior)
+	}
+	return fmt.Sprintf("%s changes from %s to %s", c.name, c.prior, c.planned)
+}
+
+// addReplacementWarning explains in the plan output why a resource is
+// replaced rather than updated in place.
+func addReplacementWarning(diags *diag.Diagnostics, summary, reason string, changes []attributeChange) {
+	if len(changes) == 0 {
+		return
+	}
+
+	lines := make([]string, 0, len(changes))
+	for _, change := range changes {
+		lines = append(lines, "  - "+change.String())
+	}
+
+	diags.AddWarning(summary, fmt.Sprintf("%s\n\n%s", reason, strings.Join(lines, "\n")))
+}
@@ -0,0 +1,208 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestAddReplacementWarning(t *testing.T) {
+	var diags diag.Diagnostics
+
+	addReplacementWarning(&diags, "Zone Replacement", "reason", nil)
+	if len(diags) != 0 {
+		t.Fatalf("expected no diagnostic without changes, got %v", diags)
+	}
+
+	addReplacementWarning(&diags, "Zone Replacement", "reason", []attributeChange{
+		{"zone_name", types.StringValue("a.example"), types.StringValue("b.example")},
+		{"zone_id", types.Int64Value(416), types.Int64Unknown()},
+	})
+	if len(diags) != 1 || diags.WarningsCount() != 1 {
+		t.Fatalf("expected a single warning, got %v", diags)
+	}
+
+	detail := diags[0].Detail()
+	for _, want := range []string{
+		`zone_name changes from "a.example" to "b.example"`,
+		`zone_id changes from 416 to a value known after apply`,
+	} {
+		if !strings.Contains(detail, want) {
+			t.Errorf("warning detail does not contain %q:\n%s", want, detail)
+		}
+	}
+}
+
+// newTestPlan returns the plan of res creating a resource with attributes,
+// every other attribute null.
+func newTestPlan(t *testing.T, res resource.Resource, attributes map[string]any) tfsdk.Plan {
+	t.Helper()
+
+	ctx := context.Background()
+	schemaResp := resource.SchemaResponse{}
+	res.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	plan := tfsdk.Plan{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	for name, value := range attributes {
+		if diags := plan.SetAttribute(ctx, path.Root(name), value); diags.HasError() {
+			t.Fatalf("unable to set %s: %v", name, diags)
+		}
+	}
+	return plan
+}
+
+// modifyTestPlan runs the ModifyPlan of res on the creation plan and returns
+// its diagnostics.
+func modifyTestPlan(t *testing.T, res resource.ResourceWithModifyPlan, plan tfsdk.Plan) diag.Diagnostics {
+	t.Helper()
+
+	ctx := context.Background()
+	state := tfsdk.State{Schema: plan.Schema, Raw: tftypes.NewValue(plan.Raw.Type(), nil)}
+	resp := resource.ModifyPlanResponse{Plan: plan}
+	res.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: plan, State: state}, &resp)
+	return resp.Diagnostics
+}
+
+func TestAssetModifyPlanZoneCheck(t *testing.T) {
+	zones := map[string]any{"/api/zones": []zoneResponse{{Id: 1, ZoneName: "example.com"}}}
+
+	for _, tc := range []struct {
+		name        string
+		responses   map[string]any
+		assetName   string
+		wantSummary string
+		wantError   bool
+	}{
+		{"in zone", zones, "www.example.com", "", false},
+		{"outside of zone", zones, "www.other.com", "Asset Outside Of Zone", true},
+		{"relative name", nil, "www", "", false},
+		{"zones unavailable", nil, "www.example.com", "Zone Check Skipped", false},
+	} {
+		res := &PanopAssetResource{clientObj: newTestClient(t, tc.responses)}
+		diags := modifyTestPlan(t, res, newTestPlan(t, res, map[string]any{
+			"asset_name": tc.assetName,
+			"asset_type": "dns",
+			"zone_id":    int64(1),
+		}))
+
+		if tc.wantSummary == "" {
+			if len(diags) != 0 {
+				t.Errorf("%s: expected no diagnostic, got %v", tc.name, diags)
+			}
+			continue
+		}
+		if len(diags) != 1 || diags[0].Summary() != tc.wantSummary || diags.HasError() != tc.wantError {
+			t.Errorf("%s: expected a single %q diagnostic, error %t, got %v", tc.name, tc.wantSummary, tc.wantError, diags)
+		}
+	}
+}
+
+func TestScopeExclusionModifyPlanZoneCheck(t *testing.T) {
+	zones := map[string]any{"/api/zones": []zoneResponse{{Id: 1, ZoneName: "example.com"}}}
+
+	for _, tc := range []struct {
+		name        string
+		responses   map[string]any
+		hosts       []string
+		wantSummary string
+		wantError   bool
+	}{
+		{"in zone", zones, []string{"www.example.com", "*.saas.example.com"}, "", false},
+		{"outside of zone", zones, []string{"www.example.com", "*.saas.other.com"}, "Host Outside Of Zone", true},
+		{"relative host", nil, []string{"www"}, "", false},
+		{"zones unavailable", nil, []string{"www.example.com"}, "Zone Check Skipped", false},
+	} {
+		res := &PanopScopeExclusionResource{clientObj: newTestClient(t, tc.responses)}
+		diags := modifyTestPlan(t, res, newTestPlan(t, res, map[string]any{
+			"zone_id": int64(1),
+			"hosts":   tc.hosts,
+		}))
+
+		if tc.wantSummary == "" {
+			if len(diags) != 0 {
+				t.Errorf("%s: expected no diagnostic, got %v", tc.name, diags)
+			}
+			continue
+		}
+		if len(diags) != 1 || diags[0].Summary() != tc.wantSummary || diags.HasError() != tc.wantError {
+			t.Errorf("%s: expected a single %q diagnostic, error %t, got %v", tc.name, tc.wantSummary, tc.wantError, diags)
+		}
+	}
+}
+
+func TestAssetModifyPlanReplacementWarning(t *testing.T) {
+	res := &PanopAssetResource{}
+	prior := newTestPlan(t, res, map[string]any{
+		"id":         int64(12),
+		"asset_name": "www.example.com",
+		"asset_type": "dns",
+		"zone_id":    int64(1),
+	})
+	plan := newTestPlan(t, res, map[string]any{
+		"id":         int64(12),
+		"asset_name": "www.example.com",
+		"asset_type": "dns",
+		"zone_id":    int64(2),
+	})
+
+	resp := resource.ModifyPlanResponse{Plan: plan}
+	res.ModifyPlan(context.Background(), resource.ModifyPlanRequest{
+		Plan:  plan,
+		State: tfsdk.State{Schema: prior.Schema, Raw: prior.Raw},
+	}, &resp)
+
+	if len(resp.Diagnostics) != 1 || resp.Diagnostics.WarningsCount() != 1 {
+		t.Fatalf("expected a single warning, got %v", resp.Diagnostics)
+	}
+	if detail := resp.Diagnostics[0].Detail(); !strings.Contains(detail, "so changing zone_id deletes the asset") {
+		t.Errorf("warning does not name the zone_id change:\n%s", detail)
+	}
+}
+
+func TestActiveUntilExpiryChangeModifier(t *testing.T) {
+	res := &PanopFindingExceptionResource{}
+	prior := newTestPlan(t, res, map[string]any{"id": int64(7), "expires_at": "2026-06-30T00:00:00Z", "active": false})
+	nullState := tfsdk.State{Schema: prior.Schema, Raw: tftypes.NewValue(prior.Raw.Type(), nil)}
+	priorState := tfsdk.State{Schema: prior.Schema, Raw: prior.Raw}
+
+	for _, tc := range []struct {
+		name      string
+		state     tfsdk.State
+		expiresAt string
+		want      types.Bool
+	}{
+		{"create", nullState, "2026-06-30T00:00:00Z", types.BoolUnknown()},
+		{"expiry unchanged", priorState, "2026-06-30T00:00:00Z", types.BoolValue(false)},
+		{"expiry moved", priorState, "2026-12-31T00:00:00Z", types.BoolUnknown()},
+	} {
+		req := planmodifier.BoolRequest{
+			Plan:       newTestPlan(t, res, map[string]any{"id": int64(7), "expires_at": tc.expiresAt}),
+			PlanValue:  types.BoolUnknown(),
+			State:      tc.state,
+			StateValue: types.BoolNull(),
+		}
+		if !tc.state.Raw.IsNull() {
+			req.StateValue = types.BoolValue(false)
+		}
+		resp := planmodifier.BoolResponse{PlanValue: req.PlanValue}
+		activeUntilExpiryChangeModifier{}.PlanModifyBool(context.Background(), req, &resp)
+
+		if resp.Diagnostics.HasError() || !resp.PlanValue.Equal(tc.want) {
+			t.Errorf("%s: expected active %s, got %s %v", tc.name, tc.want, resp.PlanValue, resp.Diagnostics)
+		}
+	}
+}
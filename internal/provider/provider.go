@@ -5,11 +5,10 @@ package provider
 
 import (
 	"context"
-	"crypto/tls"
-	"net/http"
 	"os"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -18,6 +17,7 @@ import (
 
 // Ensure PanopProvider satisfies various provider interfaces.
 var _ provider.Provider = &PanopProvider{}
+var _ provider.ProviderWithListResources = &PanopProvider{}
 
 // PanopProvider defines the provider implementation.
 type PanopProvider struct {
@@ -29,9 +29,15 @@ type PanopProvider struct {
 
 // PanopProviderModel describes the provider data model.
 type PanopProviderModel struct {
-	Host          types.String `tfsdk:"host"`
-	SkipTLSVerify types.Bool   `tfsdk:"skip_tls_verify"`
-	AccessKey     types.String `tfsdk:"access_key"`
+	Host          types.String      `tfsdk:"host"`
+	SkipTLSVerify types.Bool        `tfsdk:"skip_tls_verify"`
+	AccessKey     types.String      `tfsdk:"access_key"`
+	DefaultTags   *DefaultTagsModel `tfsdk:"default_tags"`
+}
+
+// DefaultTagsModel describes the default_tags block data model.
+type DefaultTagsModel struct {
+	Tags types.Map `tfsdk:"tags"`
 }
 
 func (p *PanopProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -55,15 +61,22 @@ func (p *PanopProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 				Optional:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"default_tags": schema.SingleNestedBlock{
+				MarkdownDescription: "Tags merged into the tags of every taggable resource, resource tags override default tags with the same key",
+				Attributes: map[string]schema.Attribute{
+					"tags": schema.MapAttribute{
+						MarkdownDescription: "Default tags",
+						ElementType:         types.StringType,
+						Optional:            true,
+						Validators:          tagsValidators,
+					},
+				},
+			},
+		},
 	}
 }
 
-type clientObj struct {
-	clientHttp *http.Client
-	host       string
-	accessKey  string
-}
-
 func (p *PanopProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
 	var data PanopProviderModel
 
@@ -73,13 +86,6 @@ func (p *PanopProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		return
 	}
 
-	// Example client configuration for data sources and resources
-	clientHttp := &http.Client{
-		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{InsecureSkipVerify: data.SkipTLSVerify.ValueBool()},
-		},
-	}
-
 	access_key := os.Getenv("PANOP_ACCESS_KEY")
 	if !data.AccessKey.IsNull() && access_key == "" {
 		access_key = data.AccessKey.ValueString()
@@ -90,25 +96,41 @@ func (p *PanopProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		host = data.Host.ValueString()
 	}
 
-	client := clientObj{
-		clientHttp: clientHttp,
-		accessKey:  access_key,
-		host:       host,
+	client := newClientObj(host, access_key, data.SkipTLSVerify.ValueBool())
+
+	if data.DefaultTags != nil {
+		defaultTags, diags := tagsFromValue(ctx, data.DefaultTags.Tags)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		client.defaultTags = defaultTags
 	}
 
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.ListResourceData = client
 }
 
 func (p *PanopProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
-		NewPanopZoneResource, NewPanopAssetResource,
+		NewPanopZoneResource, NewPanopAssetResource, NewPanopIPRangeResource,
+		NewPanopScanResource, NewPanopScanPolicyResource, NewPanopFindingExceptionResource,
+		NewPanopScopeExclusionResource, NewPanopDiscoverySeedResource,
 	}
 }
 
 func (p *PanopProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
-		NewPanopZoneDataSource, NewPanopAssetDataSource,
+		NewPanopZoneDataSource, NewPanopAssetDataSource, NewPanopFindingsDataSource,
+		NewPanopPostureDataSource, NewPanopDiscoveredAssetsDataSource, NewPanopServicesDataSource,
+		NewPanopCertificatesDataSource,
+	}
+}
+
+func (p *PanopProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		NewPanopZoneListResource, NewPanopAssetListResource,
 	}
 }
 
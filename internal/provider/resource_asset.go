@@ -4,18 +4,26 @@
 package provider
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 	"net/url"
-	"strconv"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -23,12 +31,14 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &PanopAssetResource{}
 var _ resource.ResourceWithImportState = &PanopAssetResource{}
+var _ resource.ResourceWithIdentity = &PanopAssetResource{}
+var _ resource.ResourceWithUpgradeState = &PanopAssetResource{}
+var _ resource.ResourceWithModifyPlan = &PanopAssetResource{}
+var _ resource.ResourceWithValidateConfig = &PanopAssetResource{}
 
 // PanopZoneResource defines the resource implementation.
 type PanopAssetResource struct {
-	clientHttp *http.Client
-	host       string
-	accessKey  string
+	clientObj
 }
 
 func NewPanopAssetResource() resource.Resource {
@@ -37,10 +47,123 @@ func NewPanopAssetResource() resource.Resource {
 
 // AssetResourceModel describes the resource data model.
 type AssetResourceModel struct {
+	AssetName AssetNameValue `tfsdk:"asset_name"`
+	AssetType types.String   `tfsdk:"asset_type"`
+	Id        types.Int64    `tfsdk:"id"`
+	ZoneId    types.Int64    `tfsdk:"zone_id"`
+	Hostname  types.String   `tfsdk:"hostname"`
+	Scheme    types.String   `tfsdk:"scheme"`
+	Port      types.Int64    `tfsdk:"port"`
+	Path      types.String   `tfsdk:"path"`
+	Tags      types.Map      `tfsdk:"tags"`
+	TagsAll   types.Map      `tfsdk:"tags_all"`
+
+	Criticality  types.String `tfsdk:"criticality"`
+	OwnerEmail   types.String `tfsdk:"owner_email"`
+	BusinessUnit types.String `tfsdk:"business_unit"`
+	Description  types.String `tfsdk:"description"`
+
+	Status          types.String `tfsdk:"status"`
+	FirstSeen       types.String `tfsdk:"first_seen"`
+	LastSeen        types.String `tfsdk:"last_seen"`
+	ResolvedIPs     types.List   `tfsdk:"resolved_ips"`
+	DiscoverySource types.String `tfsdk:"discovery_source"`
+	MonitoringState types.String `tfsdk:"monitoring_state"`
+
+	MonitoringEnabled types.Bool   `tfsdk:"monitoring_enabled"`
+	PauseUntil        types.String `tfsdk:"pause_until"`
+}
+
+// AssetIdentityModel describes the resource identity data model.
+type AssetIdentityModel struct {
+	ZoneId    types.Int64  `tfsdk:"zone_id"`
 	AssetName types.String `tfsdk:"asset_name"`
-	AssetType types.String `tfsdk:"asset_type"`
 	Id        types.Int64  `tfsdk:"id"`
-	ZoneId    types.Int64  `tfsdk:"zone_id"`
+}
+
+func newAssetIdentityModel(asset assetResponse) AssetIdentityModel {
+	return AssetIdentityModel{
+		ZoneId:    types.Int64Value(asset.ZoneId),
+		AssetName: types.StringValue(asset.AssetName),
+		Id:        types.Int64Value(asset.AssetId),
+	}
+}
+
+// newAssetResourceModel maps a Tower asset to the panop_asset resource model.
+func newAssetResourceModel(asset assetResponse) AssetResourceModel {
+	data := AssetResourceModel{
+		AssetName: NewAssetNameValue(asset.AssetName),
+		AssetType: types.StringValue(asset.AssetType),
+		Id:        types.Int64Value(asset.AssetId),
+		ZoneId:    types.Int64Value(asset.ZoneId),
+		Tags:      newTagsValue(types.MapNull(types.StringType), asset.Tags),
+		TagsAll:   newTagsValue(types.MapNull(types.StringType), asset.Tags),
+
+		Criticality:  optionalString(asset.Criticality),
+		OwnerEmail:   optionalString(asset.OwnerEmail),
+		BusinessUnit: optionalString(asset.BusinessUnit),
+		Description:  optionalString(asset.Description),
+	}
+	data.setDetails(asset)
+	data.setObservation(asset)
+	data.setMonitoring(asset)
+	return data
+}
+
+// setObservation sets what Tower observed of the asset, null when Tower
+// did not report it.
+func (m *AssetResourceModel) setObservation(asset assetResponse) {
+	m.Status = optionalString(asset.Status)
+	m.FirstSeen = optionalString(asset.FirstSeen)
+	m.LastSeen = optionalString(asset.LastSeen)
+	m.DiscoverySource = optionalString(asset.DiscoverySource)
+	m.MonitoringState = optionalString(asset.MonitoringState)
+
+	m.ResolvedIPs = types.ListNull(types.StringType)
+	if asset.ResolvedIPs != nil {
+		elements := make([]attr.Value, 0, len(asset.ResolvedIPs))
+		for _, ip := range asset.ResolvedIPs {
+			elements = append(elements, types.StringValue(ip))
+		}
+		m.ResolvedIPs = types.ListValueMust(types.StringType, elements)
+	}
+}
+
+// setMonitoring sets monitoring_enabled and pause_until from the monitoring
+// state of the asset in Tower.
+func (m *AssetResourceModel) setMonitoring(asset assetResponse) {
+	m.MonitoringEnabled = types.BoolValue(asset.MonitoringState != monitoringStatePaused)
+	m.PauseUntil = types.StringNull()
+	if asset.MonitoringState == monitoringStatePaused {
+		m.PauseUntil = optionalString(asset.PausedUntil)
+	}
+}
+
+// pauseExpired reports whether the model holds a monitoring pause whose
+// pause_until is past, Tower has resumed the monitoring of the asset then.
+func (m AssetResourceModel) pauseExpired(now time.Time) bool {
+	if m.MonitoringEnabled.ValueBool() || m.PauseUntil.IsNull() || m.PauseUntil.IsUnknown() {
+		return false
+	}
+	until, err := time.Parse(time.RFC3339, m.PauseUntil.ValueString())
+	return err == nil && !until.After(now)
+}
+
+// setDetails sets the host details of the asset, null when they do not
+// apply to the asset type.
+func (m *AssetResourceModel) setDetails(asset assetResponse) {
+	asset = withAssetDetails(asset)
+
+	m.Hostname = types.StringNull()
+	if asset.Hostname != "" {
+		m.Hostname = types.StringValue(asset.Hostname)
+	}
+	m.Scheme, m.Port, m.Path = types.StringNull(), types.Int64Null(), types.StringNull()
+	if asset.AssetType == assetTypeURL {
+		m.Scheme = types.StringValue(asset.Scheme)
+		m.Port = types.Int64Value(asset.Port)
+		m.Path = types.StringValue(asset.Path)
+	}
 }
 
 func (r *PanopAssetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -52,27 +175,329 @@ func (r *PanopAssetResource) Schema(ctx context.Context, req resource.SchemaRequ
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: "AssetResponse description",
 
+		// Bump Version once per release changing the schema, not once per
+		// change, and map the prior version in UpgradeState, see
+		// resource_asset_upgrade.go.
+		Version: 5,
+
 		Attributes: map[string]schema.Attribute{
 			"asset_name": schema.StringAttribute{
-				MarkdownDescription: "Asset Name",
+				MarkdownDescription: "Asset Name. For `dns` assets, either relative to the zone such as `www` or fully qualified within the zone such as `www.example.com`. For `ip` assets an IPv4 or IPv6 address, for `cidr` assets an IPv4 or IPv6 CIDR block or a single address, for `wildcard` assets a domain name under a `*` label such as `*.apps.example.com` and for `url` assets an http or https URL such as `https://shop.example.com/admin`",
+				CustomType:          AssetNameType{},
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					assetNameRequiresReplace(),
+				},
 			},
 			"asset_type": schema.StringAttribute{
-				MarkdownDescription: "Asset Type",
+				MarkdownDescription: "Asset Type, one of `dns`, `ip`, `cidr`, `wildcard` or `url`",
 				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(assetTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Asset Id",
 				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
 			},
 			"zone_id": schema.Int64Attribute{
 				Required:            true,
 				MarkdownDescription: "Zone Id",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"hostname": schema.StringAttribute{
+				MarkdownDescription: "Host name the asset is served on, the parent domain for `wildcard` assets. Null for `ip` and `cidr` assets",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"scheme": schema.StringAttribute{
+				MarkdownDescription: "URL scheme, `http` or `https`. Only set for `url` assets",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"port": schema.Int64Attribute{
+				MarkdownDescription: "URL port, the scheme default port when the URL has none. Only set for `url` assets",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "URL path. Only set for `url` assets",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tags": schema.MapAttribute{
+				MarkdownDescription: "Tags of the asset, such as environment, owner or application",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators:          tagsValidators,
+			},
+			"tags_all": schema.MapAttribute{
+				MarkdownDescription: "Tags of the asset merged with the provider `default_tags`",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"criticality": schema.StringAttribute{
+				MarkdownDescription: "Business criticality of the asset, one of `low`, `medium`, `high` or `critical`, used to prioritize its findings",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(criticalities...),
+				},
+			},
+			"owner_email": schema.StringAttribute{
+				MarkdownDescription: "Email address of the asset owner",
+				Optional:            true,
+				Validators: []validator.String{
+					emailValidator{},
+				},
+			},
+			"business_unit": schema.StringAttribute{
+				MarkdownDescription: "Business unit the asset belongs to",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the asset",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 1024),
+				},
+			},
+			"monitoring_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether Tower monitors the asset. Setting it to `false` pauses the monitoring, the asset and its history are kept. Defaults to `true`",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"pause_until": schema.StringAttribute{
+				MarkdownDescription: "RFC 3339 timestamp at which Tower resumes the monitoring of a paused asset, such as `2026-01-31T18:00:00Z`. Only valid when `monitoring_enabled` is `false`, the pause lasts until `monitoring_enabled` is set back to `true` when null",
+				Optional:            true,
+				Validators: []validator.String{
+					timestampValidator{},
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status of the asset as observed by Tower",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"first_seen": schema.StringAttribute{
+				MarkdownDescription: "RFC 3339 timestamp of the first observation of the asset by Tower",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_seen": schema.StringAttribute{
+				MarkdownDescription: "RFC 3339 timestamp of the last observation of the asset by Tower",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"resolved_ips": schema.ListAttribute{
+				MarkdownDescription: "IP addresses the asset resolved to at its last observation",
+				ElementType:         types.StringType,
+				Computed:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"discovery_source": schema.StringAttribute{
+				MarkdownDescription: "How Tower learned of the asset, `manual` when it was registered through the API or Terraform, `discovered` when Tower found it and `adopted` once such an asset is managed by a `panop_asset` resource",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"monitoring_state": schema.StringAttribute{
+				MarkdownDescription: "Monitoring state of the asset in Tower, such as `active` or `paused`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 		},
 	}
 }
 
+func (r *PanopAssetResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"zone_id": identityschema.Int64Attribute{
+				Description:       "Zone Id",
+				OptionalForImport: true,
+			},
+			"asset_name": identityschema.StringAttribute{
+				Description:       "Asset Name",
+				OptionalForImport: true,
+			},
+			"id": identityschema.Int64Attribute{
+				Description:       "Asset Id",
+				OptionalForImport: true,
+			},
+		},
+	}
+}
+
+func (r *PanopAssetResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data AssetResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.PauseUntil.IsNull() && data.MonitoringEnabled.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("pause_until"),
+			"Invalid Monitoring Pause",
+			"pause_until can only be set when monitoring_enabled is false.",
+		)
+	}
+
+	// The asset name syntax depends on the asset type.
+	if data.AssetName.IsNull() || data.AssetName.IsUnknown() || data.AssetType.IsNull() || data.AssetType.IsUnknown() {
+		return
+	}
+
+	if err := validateAssetName(data.AssetType.ValueString(), data.AssetName.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("asset_name"),
+			"Invalid Asset Name",
+			fmt.Sprintf("%q is not a valid asset name: %s.", data.AssetName.ValueString(), err),
+		)
+		return
+	}
+
+	// The zone name is only known to Tower, ModifyPlan checks the fully
+	// qualified names against it.
+	if _, err := assetZoneHostname(data.AssetType.ValueString(), data.AssetName.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("asset_name"),
+			"Asset Outside Of Zone",
+			fmt.Sprintf("%q does not belong to a zone: %s.", data.AssetName.ValueString(), err),
+		)
+	}
+}
+
+func (r *PanopAssetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data AssetResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tagsAll, diags := planTagsAll(ctx, data.Tags, r.defaultTags)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags_all"), tagsAll)...)
+
+	// The zone is looked up when the asset is created, renamed or moved.
+	checkZone := true
+	if !req.State.Raw.IsNull() {
+		var state AssetResourceModel
+
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		var changes []attributeChange
+		if normalizeAssetName(state.AssetName.ValueString()) != normalizeAssetName(data.AssetName.ValueString()) {
+			changes = append(changes, attributeChange{"asset_name", state.AssetName, data.AssetName})
+		}
+		if !state.AssetType.Equal(data.AssetType) {
+			changes = append(changes, attributeChange{"asset_type", state.AssetType, data.AssetType})
+		}
+		if !state.ZoneId.Equal(data.ZoneId) {
+			changes = append(changes, attributeChange{"zone_id", state.ZoneId, data.ZoneId})
+		}
+		checkZone = len(changes) > 0
+
+		// A discovered asset is adopted by the first apply after its import.
+		if state.DiscoverySource.ValueString() == discoverySourceDiscovered {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("discovery_source"), types.StringUnknown())...)
+		}
+
+		// Pausing or resuming the monitoring changes the monitoring state.
+		if !state.MonitoringEnabled.Equal(data.MonitoringEnabled) || !state.PauseUntil.Equal(data.PauseUntil) {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("monitoring_state"), types.StringUnknown())...)
+		}
+
+		names := make([]string, 0, len(changes))
+		for _, change := range changes {
+			names = append(names, change.name)
+		}
+		addReplacementWarning(&resp.Diagnostics, "Asset Replacement",
+			fmt.Sprintf("Tower cannot change the asset_name, asset_type or zone_id of an asset, so changing %s deletes the asset %q (id %d) and creates it again with a new id.",
+				strings.Join(names, " and "), state.AssetName.ValueString(), state.Id.ValueInt64()),
+			changes)
+	}
+
+	// The asset config only carries the zone id, so the zone name is looked
+	// up in Tower to check the asset name belongs to the zone.
+	if !checkZone || r.clientHttp == nil || data.ZoneId.IsUnknown() || data.AssetName.IsUnknown() || data.AssetType.IsUnknown() {
+		return
+	}
+	hostname, err := assetZoneHostname(data.AssetType.ValueString(), data.AssetName.ValueString())
+	if err != nil || hostname == "" {
+		return
+	}
+
+	zones, err := r.listZones(ctx)
+	if err != nil {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("zone_id"),
+			"Zone Check Skipped",
+			fmt.Sprintf("Unable to list zones to check the asset %q belongs to the zone %d, got error: %s",
+				data.AssetName.ValueString(), data.ZoneId.ValueInt64(), err),
+		)
+		return
+	}
+
+	for _, zone := range zones {
+		if zone.Id == data.ZoneId.ValueInt64() {
+			if !assetInZone(hostname, zone.ZoneName) {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("asset_name"),
+					"Asset Outside Of Zone",
+					fmt.Sprintf("The asset %q does not belong to the zone %q (id %d).",
+						data.AssetName.ValueString(), zone.ZoneName, zone.Id),
+				)
+			}
+			return
+		}
+	}
+}
+
 func (r *PanopAssetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -89,9 +514,7 @@ func (r *PanopAssetResource) Configure(ctx context.Context, req resource.Configu
 
 		return
 	}
-	r.clientHttp = client.clientHttp
-	r.host = client.host
-	r.accessKey = client.accessKey
+	r.clientObj = client
 }
 
 func (r *PanopAssetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -104,63 +527,70 @@ func (r *PanopAssetResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	// Tower call.
-	urlSvc := url.URL{
-		Scheme: "https",
-		Host:   r.host,
-		Path:   "/api/assets",
-	}
-	type AssetInput struct {
-		AssetName string `json:"asset_name"`
-		AssetType string `json:"asset_type"`
-		ZoneId    int64  `json:"zone_id"`
+	// Tower holds the tags merged with the provider default tags.
+	tags, diags := tagsFromValue(ctx, data.TagsAll)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	assetInput := AssetInput{
-		AssetName: data.AssetName.ValueString(),
+
+	// Tower call
+	created, err := r.createAsset(ctx, assetInput{
+		AssetName: canonicalAssetName(data.AssetType.ValueString(), data.AssetName.ValueString()),
 		AssetType: data.AssetType.ValueString(),
 		ZoneId:    data.ZoneId.ValueInt64(),
-	}
-	body, _ := json.Marshal(assetInput)
+		Tags:      tags,
 
-	httpReq, err := http.NewRequest(http.MethodPost, urlSvc.String(), bytes.NewReader(body))
+		Criticality:  data.Criticality.ValueString(),
+		OwnerEmail:   data.OwnerEmail.ValueString(),
+		BusinessUnit: data.BusinessUnit.ValueString(),
+		Description:  data.Description.ValueString(),
+	})
 	if err != nil {
-		resp.Diagnostics.AddError("JSON Marshal Error", fmt.Sprintf("Unable to marshal assetInput to JSON, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create asset, got error: %s", err))
 		return
 	}
 
-	httpReq.Header.Add("Authorization", fmt.Sprintf("Bearer %s", r.accessKey))
-	httpReq.Header.Add("Content-Type", "application/json")
+	data.AssetName = NewAssetNameValue(created.AssetName)
+	data.Id = types.Int64Value(created.AssetId)
+	data.setDetails(assetResponse{
+		AssetName: created.AssetName,
+		AssetType: data.AssetType.ValueString(),
+		Hostname:  created.Hostname,
+		Scheme:    created.Scheme,
+		Port:      created.Port,
+		Path:      created.Path,
+	})
+	// Tower does not return the observation of a new asset, it is read
+	// back from the asset list.
+	data.setObservation(assetResponse{})
+
+	// The asset is tracked before its monitoring is paused, so that a
+	// failed pause leaves a tainted resource rather than an orphan asset.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, newAssetIdentityModel(assetResponse{
+		AssetId:   data.Id.ValueInt64(),
+		AssetName: data.AssetName.ValueString(),
+		ZoneId:    data.ZoneId.ValueInt64(),
+	}))...)
 
-	httpResp, err := r.clientHttp.Do(httpReq)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create example, got error: %s", err))
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	type AssetResponse struct {
-		AssetId   uint   `json:"asset_id"`
-		AssetName string `json:"asset_name"`
-		AssetType string `json:"asset_type"`
-	}
-
-	respBody, err := io.ReadAll(httpResp.Body)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create asset, got error: %s", err))
-		return
+	if !data.MonitoringEnabled.ValueBool() {
+		if err := r.pauseAsset(ctx, data.Id.ValueInt64(), assetPauseInput{Until: data.PauseUntil.ValueString()}); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to pause asset monitoring, got error: %s", err))
+			return
+		}
 	}
 
-	if httpResp.StatusCode != http.StatusCreated {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create asset, got error: %s",
-			httpResp.Status))
-		return
+	if asset, found, err := r.findAsset(ctx, data.Id.ValueInt64()); err != nil {
+		tflog.Warn(ctx, "unable to read the created asset, leaving its observation unset", map[string]any{"error": err.Error()})
+	} else if found {
+		data.setObservation(asset)
 	}
 
-	zone := AssetResponse{}
-	_ = json.Unmarshal(respBody, &zone)
-
-	data.AssetName = types.StringValue(zone.AssetName)
-	data.Id = types.Int64Value(int64(zone.AssetId))
-
 	tflog.Trace(ctx, "created a resource")
 
 	// Save data into Terraform state
@@ -178,64 +608,124 @@ func (r *PanopAssetResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	// Tower call
-	urlSvc := url.URL{
-		Scheme: "https",
-		Host:   r.host,
-		Path:   "/api/assets",
-	}
-	httpReq, err := http.NewRequest(http.MethodGet, urlSvc.String(), nil)
+	asset, found, err := r.findAsset(ctx, data.Id.ValueInt64())
 	if err != nil {
-		resp.Diagnostics.AddError("HTTP request creation error", err.Error())
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read asset, got error: %s", err))
 		return
 	}
 
-	httpReq.Header.Add("Authorization", fmt.Sprintf("Bearer %s", r.accessKey))
-	httpReq.Header.Add("Content-Type", "application/json")
-
-	httpResp, err := r.clientHttp.Do(httpReq)
-	if err != nil {
-		resp.Diagnostics.AddError("HTTP request error", err.Error())
+	// The asset was deleted outside of Terraform.
+	if !found {
+		resp.State.RemoveResource(ctx)
 		return
 	}
 
-	type AssetResponse struct {
-		AssetId   int64  `json:"id"`
-		AssetName string `json:"asset_name"`
-		AssetType string `json:"asset_type"`
-		ZoneId    int64  `json:"zone_id"`
+	data.AssetName = NewAssetNameValue(asset.AssetName)
+	data.AssetType = types.StringValue(asset.AssetType)
+	data.ZoneId = types.Int64Value(asset.ZoneId)
+	data.Tags, data.TagsAll = readTags(data.Tags, asset.Tags, r.defaultTags)
+	data.Criticality = optionalString(asset.Criticality)
+	data.OwnerEmail = optionalString(asset.OwnerEmail)
+	data.BusinessUnit = optionalString(asset.BusinessUnit)
+	data.Description = optionalString(asset.Description)
+	data.setDetails(asset)
+	data.setObservation(asset)
+	// A pause Tower ended at pause_until is not drift.
+	if asset.MonitoringState == monitoringStatePaused || !data.pauseExpired(time.Now()) {
+		data.setMonitoring(asset)
 	}
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, newAssetIdentityModel(asset))...)
 
-	respBody, err := io.ReadAll(httpResp.Body)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create zonne, got error: %s", err))
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PanopAssetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AssetResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	if httpResp.StatusCode != http.StatusOK {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create zone, got error: %s",
-			httpResp.Status))
+	var state AssetResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	assets := []AssetResponse{}
-	_ = json.Unmarshal(respBody, &assets)
-	// this is the end of tower call
-	for _, asset := range assets {
-		if asset.AssetId == data.Id.ValueInt64() {
-			data.AssetName = types.StringValue(asset.AssetName)
-			data.AssetType = types.StringValue(asset.AssetType)
-			data.ZoneId = types.Int64Value(asset.ZoneId)
-			break
+	// Tags and business metadata are the attributes Tower updates in
+	// place, other changes are cosmetic such as the case of asset_name.
+	// Tower holds the tags merged with the provider default tags.
+	if !data.TagsAll.Equal(state.TagsAll) ||
+		!data.Criticality.Equal(state.Criticality) ||
+		!data.OwnerEmail.Equal(state.OwnerEmail) ||
+		!data.BusinessUnit.Equal(state.BusinessUnit) ||
+		!data.Description.Equal(state.Description) {
+		tags, diags := tagsFromValue(ctx, data.TagsAll)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if tags == nil {
+			tags = map[string]string{}
+		}
+
+		// Tower call
+		input := assetUpdateInput{
+			Tags:         tags,
+			Criticality:  data.Criticality.ValueString(),
+			OwnerEmail:   data.OwnerEmail.ValueString(),
+			BusinessUnit: data.BusinessUnit.ValueString(),
+			Description:  data.Description.ValueString(),
+		}
+		if err := r.updateAsset(ctx, data.Id.ValueInt64(), input); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update asset, got error: %s", err))
+			return
+		}
+	}
+
+	// Tower call
+	if state.DiscoverySource.ValueString() == discoverySourceDiscovered {
+		if err := r.adoptAsset(ctx, data.Id.ValueInt64()); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to adopt asset, got error: %s", err))
+			return
+		}
+		data.DiscoverySource = types.StringValue(discoverySourceAdopted)
+	}
+
+	// Tower call
+	if !data.MonitoringEnabled.Equal(state.MonitoringEnabled) || !data.PauseUntil.Equal(state.PauseUntil) {
+		var err error
+		if data.MonitoringEnabled.ValueBool() {
+			err = r.resumeAsset(ctx, data.Id.ValueInt64())
+		} else {
+			err = r.pauseAsset(ctx, data.Id.ValueInt64(), assetPauseInput{Until: data.PauseUntil.ValueString()})
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update asset monitoring, got error: %s", err))
+			return
+		}
+
+		asset, found, err := r.findAsset(ctx, data.Id.ValueInt64())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read asset, got error: %s", err))
+			return
+		}
+		data.MonitoringState = types.StringNull()
+		if found {
+			data.MonitoringState = optionalString(asset.MonitoringState)
 		}
 	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *PanopAssetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddError("Not implemented", "Unable to update asset")
-}
-
 func (r *PanopAssetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var data AssetResourceModel
 
@@ -275,6 +765,24 @@ func (r *PanopAssetResource) Delete(ctx context.Context, req resource.DeleteRequ
 }
 
 func (r *PanopAssetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	id, _ := strconv.ParseInt(req.ID, 10, 64)
+	var id int64
+	var diags diag.Diagnostics
+
+	// Import blocks may use the resource identity instead of an import id.
+	if req.ID == "" && req.Identity != nil {
+		var identity AssetIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		id, diags = resolveAssetIdentity(ctx, r.clientObj, identity)
+	} else {
+		id, diags = resolveAssetImportID(ctx, r.clientObj, req.ID)
+	}
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
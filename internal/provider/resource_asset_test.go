@@ -6,9 +6,15 @@ package provider
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestAccAssetResource(t *testing.T) {
@@ -23,6 +29,8 @@ func TestAccAssetResource(t *testing.T) {
 					resource.TestCheckResourceAttr("panop_asset.test", "asset_name", "www"),
 					resource.TestCheckResourceAttr("panop_asset.test", "asset_type", "dns"),
 					resource.TestCheckResourceAttr("panop_asset.test", "zone_id", "337"),
+					resource.TestCheckResourceAttr("panop_asset.test", "discovery_source", "manual"),
+					resource.TestCheckResourceAttrSet("panop_asset.test", "status"),
 				),
 			},
 			// ImportState testing
@@ -30,11 +38,294 @@ func TestAccAssetResource(t *testing.T) {
 				ResourceName:      "panop_asset.test",
 				ImportState:       true,
 				ImportStateVerify: true,
+				// Tower may observe the asset again between create and import.
+				ImportStateVerifyIgnore: []string{"last_seen", "status", "resolved_ips"},
 			},
 		},
 	})
 }
 
+func TestAccAssetResource_identity(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			// Create and identity testing
+			{
+				Config: getProviderConfig(os.Getenv("PANOP_ACCESS_KEY")) + testAccAssetResourceConfig("www", "dns", 337),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentityValue("panop_asset.test", tfjsonpath.New("asset_name"), knownvalue.StringExact("www")),
+					statecheck.ExpectIdentityValue("panop_asset.test", tfjsonpath.New("zone_id"), knownvalue.Int64Exact(337)),
+					statecheck.ExpectIdentityValueMatchesState("panop_asset.test", tfjsonpath.New("id")),
+				},
+			},
+			// ImportState by identity testing
+			{
+				ResourceName:    "panop_asset.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
+func TestAccAssetResource_wildcardAndURL(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Wildcard testing
+			{
+				Config: getProviderConfig(os.Getenv("PANOP_ACCESS_KEY")) + testAccAssetResourceConfig("*.apps.ducksifiedshop.com", "wildcard", 337),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("panop_asset.test", "asset_name", "*.apps.ducksifiedshop.com"),
+					resource.TestCheckResourceAttr("panop_asset.test", "hostname", "apps.ducksifiedshop.com"),
+					resource.TestCheckNoResourceAttr("panop_asset.test", "scheme"),
+				),
+			},
+			// URL testing, the name is normalized by Tower
+			{
+				Config: getProviderConfig(os.Getenv("PANOP_ACCESS_KEY")) + testAccAssetResourceConfig("HTTPS://Shop.ducksifiedshop.com:443/admin", "url", 337),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("panop_asset.test", "asset_name", "HTTPS://Shop.ducksifiedshop.com:443/admin"),
+					resource.TestCheckResourceAttr("panop_asset.test", "hostname", "shop.ducksifiedshop.com"),
+					resource.TestCheckResourceAttr("panop_asset.test", "scheme", "https"),
+					resource.TestCheckResourceAttr("panop_asset.test", "port", "443"),
+					resource.TestCheckResourceAttr("panop_asset.test", "path", "/admin"),
+				),
+			},
+			// Semantically equal URL, updated in place
+			{
+				Config: getProviderConfig(os.Getenv("PANOP_ACCESS_KEY")) + testAccAssetResourceConfig("https://shop.ducksifiedshop.com/admin", "url", 337),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("panop_asset.test", plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+			// Invalid URL
+			{
+				Config:      getProviderConfig(os.Getenv("PANOP_ACCESS_KEY")) + testAccAssetResourceConfig("ftp://shop.ducksifiedshop.com/", "url", 337),
+				ExpectError: regexp.MustCompile("Invalid Asset Name"),
+			},
+		},
+	})
+}
+
+func TestAccAssetResource_tags(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with tags and filter the data source by tag testing
+			{
+				Config: getProviderConfig(os.Getenv("PANOP_ACCESS_KEY")) + testAccAssetResourceTagsConfig("prod"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("panop_asset.test", "tags.env", "prod"),
+					resource.TestCheckResourceAttr("data.panop_asset.test", "assets.#", "1"),
+					resource.TestCheckResourceAttrPair("data.panop_asset.test", "assets.0.id", "panop_asset.test", "id"),
+					resource.TestCheckResourceAttr("data.panop_asset.test", "assets.0.tags.application", "shop"),
+				),
+			},
+			// Update tags in place testing
+			{
+				Config: getProviderConfig(os.Getenv("PANOP_ACCESS_KEY")) + testAccAssetResourceTagsConfig("staging"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("panop_asset.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("panop_asset.test", "tags.env", "staging"),
+			},
+		},
+	})
+}
+
+func TestAccAssetResource_defaultTags(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Default tags merged into tags_all testing
+			{
+				Config: testAccAssetResourceDefaultTagsConfig(os.Getenv("PANOP_ACCESS_KEY"), "prod"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("panop_asset.test", "tags.%", "1"),
+					resource.TestCheckResourceAttr("panop_asset.test", "tags_all.%", "2"),
+					resource.TestCheckResourceAttr("panop_asset.test", "tags_all.team", "security"),
+					resource.TestCheckResourceAttr("panop_asset.test", "tags_all.env", "prod"),
+				),
+			},
+			// Resource tags override default tags testing
+			{
+				Config: testAccAssetResourceDefaultTagsConfig(os.Getenv("PANOP_ACCESS_KEY"), "security"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("panop_asset.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("panop_asset.test", "tags_all.%", "2"),
+			},
+		},
+	})
+}
+
+func TestAccAssetResource_metadata(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with business metadata testing
+			{
+				Config: getProviderConfig(os.Getenv("PANOP_ACCESS_KEY")) + testAccAssetResourceMetadataConfig("high"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("panop_asset.test", "criticality", "high"),
+					resource.TestCheckResourceAttr("panop_asset.test", "owner_email", "secops@ducksifiedshop.com"),
+					resource.TestCheckResourceAttr("panop_asset.test", "business_unit", "ecommerce"),
+				),
+			},
+			// Update in place testing
+			{
+				Config: getProviderConfig(os.Getenv("PANOP_ACCESS_KEY")) + testAccAssetResourceMetadataConfig("critical"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("panop_asset.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("panop_asset.test", "criticality", "critical"),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "panop_asset.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Invalid criticality testing
+			{
+				Config:      getProviderConfig(os.Getenv("PANOP_ACCESS_KEY")) + testAccAssetResourceMetadataConfig("urgent"),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+		},
+	})
+}
+
+func TestAccAssetResource_monitoring(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with paused monitoring testing
+			{
+				Config: getProviderConfig(os.Getenv("PANOP_ACCESS_KEY")) + testAccAssetResourceMonitoringConfig(false, `"2099-01-01T00:00:00Z"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("panop_asset.test", "monitoring_enabled", "false"),
+					resource.TestCheckResourceAttr("panop_asset.test", "monitoring_state", "paused"),
+				),
+			},
+			// Resume in place testing
+			{
+				Config: getProviderConfig(os.Getenv("PANOP_ACCESS_KEY")) + testAccAssetResourceMonitoringConfig(true, "null"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("panop_asset.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("panop_asset.test", "monitoring_enabled", "true"),
+					resource.TestCheckNoResourceAttr("panop_asset.test", "pause_until"),
+				),
+			},
+			// Pause end without pause testing
+			{
+				Config:      getProviderConfig(os.Getenv("PANOP_ACCESS_KEY")) + testAccAssetResourceMonitoringConfig(true, `"2099-01-01T00:00:00Z"`),
+				ExpectError: regexp.MustCompile("Invalid Monitoring Pause"),
+			},
+			// Invalid pause end testing
+			{
+				Config:      getProviderConfig(os.Getenv("PANOP_ACCESS_KEY")) + testAccAssetResourceMonitoringConfig(false, `"next monday"`),
+				ExpectError: regexp.MustCompile("Invalid Timestamp"),
+			},
+		},
+	})
+}
+
+func testAccAssetResourceMonitoringConfig(enabled bool, pauseUntil string) string {
+	return fmt.Sprintf(`
+resource "panop_asset" "test" {
+  asset_name         = "shop"
+  asset_type         = "dns"
+  zone_id            = 337
+  monitoring_enabled = %t
+  pause_until        = %s
+}
+`, enabled, pauseUntil)
+}
+
+func testAccAssetResourceMetadataConfig(criticality string) string {
+	return fmt.Sprintf(`
+resource "panop_asset" "test" {
+  asset_name    = "shop"
+  asset_type    = "dns"
+  zone_id       = 337
+  criticality   = %q
+  owner_email   = "secops@ducksifiedshop.com"
+  business_unit = "ecommerce"
+  description   = "Customer facing web shop"
+}
+`, criticality)
+}
+
+func testAccAssetResourceDefaultTagsConfig(accessKey, env string) string {
+	return fmt.Sprintf(`
+provider "panop" {
+  skip_tls_verify = true
+  host            = "tower.panop.io"
+  access_key      = %[1]q
+
+  default_tags {
+    tags = {
+      team = "security"
+      env  = "prod"
+    }
+  }
+}
+
+resource "panop_asset" "test" {
+  asset_name = "shop"
+  asset_type = "dns"
+  zone_id = 337
+  tags = {
+    env = %[2]q
+  }
+}
+`, accessKey, env)
+}
+
+func testAccAssetResourceTagsConfig(env string) string {
+	return fmt.Sprintf(`
+resource "panop_asset" "test" {
+  asset_name = "shop"
+  asset_type = "dns"
+  zone_id = 337
+  tags = {
+    env         = %[1]q
+    application = "shop"
+  }
+}
+
+data "panop_asset" "test" {
+  zone_id = panop_asset.test.zone_id
+  tags = {
+    env         = %[1]q
+    application = "shop"
+  }
+}
+`, env)
+}
+
 func testAccAssetResourceConfig(assetName, assetType string, zoneId int64) string {
 	return fmt.Sprintf(`
 resource "panop_asset" "test" {
@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// assetResourceModelV4 describes the version 4 resource data model, less
+// the host details.
+type assetResourceModelV4 struct {
+	AssetName types.String `tfsdk:"asset_name"`
+	AssetType types.String `tfsdk:"asset_type"`
+	Id        types.Int64  `tfsdk:"id"`
+	ZoneId    types.Int64  `tfsdk:"zone_id"`
+	Tags      types.Map    `tfsdk:"tags"`
+	TagsAll   types.Map    `tfsdk:"tags_all"`
+
+	Criticality  types.String `tfsdk:"criticality"`
+	OwnerEmail   types.String `tfsdk:"owner_email"`
+	BusinessUnit types.String `tfsdk:"business_unit"`
+	Description  types.String `tfsdk:"description"`
+
+	Status          types.String `tfsdk:"status"`
+	FirstSeen       types.String `tfsdk:"first_seen"`
+	LastSeen        types.String `tfsdk:"last_seen"`
+	ResolvedIPs     types.List   `tfsdk:"resolved_ips"`
+	DiscoverySource types.String `tfsdk:"discovery_source"`
+	MonitoringState types.String `tfsdk:"monitoring_state"`
+}
+
+// assetSchemaV4 is the schema of panop_asset version 4, it has no
+// monitoring_enabled nor pause_until. The host details are left out and
+// derived again from the asset name.
+//
+// Version 0 to 3 states are read with this schema too, version 0 has no
+// tags, version 1 no tags_all, version 2 no business metadata and version
+// 3 no observation attributes. Attributes missing from a state are read as
+// null.
+var assetSchemaV4 = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"asset_name": schema.StringAttribute{
+			Required: true,
+		},
+		"asset_type": schema.StringAttribute{
+			Required: true,
+		},
+		"id": schema.Int64Attribute{
+			Computed: true,
+		},
+		"zone_id": schema.Int64Attribute{
+			Required: true,
+		},
+		"tags": schema.MapAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+		},
+		"tags_all": schema.MapAttribute{
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+		"criticality": schema.StringAttribute{
+			Optional: true,
+		},
+		"owner_email": schema.StringAttribute{
+			Optional: true,
+		},
+		"business_unit": schema.StringAttribute{
+			Optional: true,
+		},
+		"description": schema.StringAttribute{
+			Optional: true,
+		},
+		"status": schema.StringAttribute{
+			Computed: true,
+		},
+		"first_seen": schema.StringAttribute{
+			Computed: true,
+		},
+		"last_seen": schema.StringAttribute{
+			Computed: true,
+		},
+		"resolved_ips": schema.ListAttribute{
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+		"discovery_source": schema.StringAttribute{
+			Computed: true,
+		},
+		"monitoring_state": schema.StringAttribute{
+			Computed: true,
+		},
+	},
+}
+
+func (r *PanopAssetResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	upgrader := resource.StateUpgrader{
+		PriorSchema:   &assetSchemaV4,
+		StateUpgrader: upgradeAssetState,
+	}
+
+	return map[int64]resource.StateUpgrader{
+		0: upgrader,
+		1: upgrader,
+		2: upgrader,
+		3: upgrader,
+		4: upgrader,
+	}
+}
+
+// upgradeAssetState upgrades a version 0 to 4 state to the current version.
+// The host details are derived from the asset name, a missing tags_all
+// equals tags as Tower held no default tags, a missing observation is left
+// null until the next refresh and monitoring_enabled follows the monitoring
+// state.
+func upgradeAssetState(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var prior assetResourceModelV4
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data := newAssetResourceModel(assetResponse{
+		AssetId:   prior.Id.ValueInt64(),
+		AssetName: prior.AssetName.ValueString(),
+		AssetType: prior.AssetType.ValueString(),
+		ZoneId:    prior.ZoneId.ValueInt64(),
+	})
+	data.Tags = prior.Tags
+	data.TagsAll = prior.TagsAll
+	if data.TagsAll.IsNull() {
+		data.TagsAll = prior.Tags
+	}
+	data.Criticality = prior.Criticality
+	data.OwnerEmail = prior.OwnerEmail
+	data.BusinessUnit = prior.BusinessUnit
+	data.Description = prior.Description
+	data.Status = prior.Status
+	data.FirstSeen = prior.FirstSeen
+	data.LastSeen = prior.LastSeen
+	data.ResolvedIPs = prior.ResolvedIPs
+	data.DiscoverySource = prior.DiscoverySource
+	data.MonitoringState = prior.MonitoringState
+	data.setMonitoring(assetResponse{MonitoringState: prior.MonitoringState.ValueString()})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestAssetResourceUpgradeStateV0(t *testing.T) {
+	attributes := testUpgradeResourceState(t, "panop_asset", 0,
+		`{"id": 1234, "asset_name": "https://shop.example.com/admin", "asset_type": "url", "zone_id": 416}`)
+
+	var hostname string
+	if err := attributes["hostname"].As(&hostname); err != nil || hostname != "shop.example.com" {
+		t.Errorf("expected hostname shop.example.com, got %q (%v)", hostname, err)
+	}
+	if !attributes["port"].Equal(tftypes.NewValue(tftypes.Number, 443)) {
+		t.Errorf("expected port 443, got %s", attributes["port"])
+	}
+	if !attributes["tags"].IsNull() {
+		t.Errorf("expected tags to be null, got %s", attributes["tags"])
+	}
+}
+
+func TestAssetResourceUpgradeStateV1(t *testing.T) {
+	attributes := testUpgradeResourceState(t, "panop_asset", 1,
+		`{"id": 1234, "asset_name": "www", "asset_type": "dns", "zone_id": 416, "hostname": "www", "tags": {"env": "prod"}}`)
+
+	if !attributes["tags_all"].Equal(attributes["tags"]) || attributes["tags_all"].IsNull() {
+		t.Errorf("expected tags_all to equal tags, got %s and %s", attributes["tags_all"], attributes["tags"])
+	}
+}
+
+func TestAssetResourceUpgradeStateV2(t *testing.T) {
+	attributes := testUpgradeResourceState(t, "panop_asset", 2,
+		`{"id": 1234, "asset_name": "*.apps.example.com", "asset_type": "wildcard", "zone_id": 416, "hostname": "apps.example.com", "tags": {"env": "prod"}, "tags_all": {"env": "prod", "team": "secops"}}`)
+
+	if !attributes["tags_all"].Equal(tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+		"env":  tftypes.NewValue(tftypes.String, "prod"),
+		"team": tftypes.NewValue(tftypes.String, "secops"),
+	})) {
+		t.Errorf("expected tags_all to be kept, got %s", attributes["tags_all"])
+	}
+	for _, name := range []string{"criticality", "owner_email", "business_unit", "description"} {
+		if !attributes[name].IsNull() {
+			t.Errorf("expected %s to be null, got %s", name, attributes[name])
+		}
+	}
+	if !attributes["monitoring_enabled"].Equal(tftypes.NewValue(tftypes.Bool, true)) {
+		t.Errorf("expected monitoring_enabled true, got %s", attributes["monitoring_enabled"])
+	}
+}
+
+func TestAssetResourceUpgradeStateV3(t *testing.T) {
+	attributes := testUpgradeResourceState(t, "panop_asset", 3,
+		`{"id": 1234, "asset_name": "www", "asset_type": "dns", "zone_id": 416, "hostname": "www", "criticality": "high", "owner_email": "secops@example.com"}`)
+
+	var criticality string
+	if err := attributes["criticality"].As(&criticality); err != nil || criticality != "high" {
+		t.Errorf("expected criticality high, got %q (%v)", criticality, err)
+	}
+	for _, name := range []string{"status", "first_seen", "last_seen", "resolved_ips", "discovery_source", "monitoring_state"} {
+		if !attributes[name].IsNull() {
+			t.Errorf("expected %s to be null, got %s", name, attributes[name])
+		}
+	}
+}
+
+func TestAssetResourceUpgradeStateV4(t *testing.T) {
+	attributes := testUpgradeResourceState(t, "panop_asset", 4,
+		`{"id": 1234, "asset_name": "www", "asset_type": "dns", "zone_id": 416, "hostname": "www", "status": "active", "monitoring_state": "paused"}`)
+
+	if !attributes["monitoring_enabled"].Equal(tftypes.NewValue(tftypes.Bool, false)) {
+		t.Errorf("expected monitoring_enabled false, got %s", attributes["monitoring_enabled"])
+	}
+	if !attributes["status"].Equal(tftypes.NewValue(tftypes.String, "active")) {
+		t.Errorf("expected status active, got %s", attributes["status"])
+	}
+}
@@ -0,0 +1,237 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PanopDiscoverySeedResource{}
+var _ resource.ResourceWithImportState = &PanopDiscoverySeedResource{}
+var _ resource.ResourceWithValidateConfig = &PanopDiscoverySeedResource{}
+
+func NewPanopDiscoverySeedResource() resource.Resource {
+	return &PanopDiscoverySeedResource{}
+}
+
+// PanopDiscoverySeedResource defines the resource implementation.
+type PanopDiscoverySeedResource struct {
+	clientObj
+}
+
+// DiscoverySeedResourceModel describes the resource data model.
+type DiscoverySeedResourceModel struct {
+	Id       types.Int64  `tfsdk:"id"`
+	ZoneId   types.Int64  `tfsdk:"zone_id"`
+	SeedType types.String `tfsdk:"seed_type"`
+	Value    types.String `tfsdk:"value"`
+}
+
+// setSeed sets the model from a Tower discovery seed.
+func (m *DiscoverySeedResourceModel) setSeed(seed discoverySeedResponse) {
+	m.Id = types.Int64Value(seed.Id)
+	m.ZoneId = types.Int64Value(seed.ZoneId)
+	m.SeedType = types.StringValue(seed.SeedType)
+	m.Value = types.StringValue(seed.Value)
+}
+
+func (r *PanopDiscoverySeedResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_discovery_seed"
+}
+
+func (r *PanopDiscoverySeedResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Organization name, AS number or keyword from which Tower discovers the assets of a zone, " +
+			"when the `auto_discovery` of the zone is enabled",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Discovery Seed Id",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"zone_id": schema.Int64Attribute{
+				MarkdownDescription: "Id of the zone the discovered assets are attached to",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"seed_type": schema.StringAttribute{
+				MarkdownDescription: "Seed type, `organization` for a registrant or certificate organization name, `asn` for an AS number or `keyword` for a brand or product name",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(discoverySeedTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"value": schema.StringAttribute{
+				MarkdownDescription: "Seed value, such as `Ducksified Inc.`, `AS64496` or `ducksified`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *PanopDiscoverySeedResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data DiscoverySeedResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The value syntax depends on the seed type.
+	if data.SeedType.IsNull() || data.SeedType.IsUnknown() || data.Value.IsNull() || data.Value.IsUnknown() {
+		return
+	}
+
+	if err := validateDiscoverySeed(data.SeedType.ValueString(), data.Value.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("value"),
+			"Invalid Discovery Seed",
+			fmt.Sprintf("%q is not a valid %s seed: %s.", data.Value.ValueString(), data.SeedType.ValueString(), err),
+		)
+	}
+}
+
+func (r *PanopDiscoverySeedResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(clientObj)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected clientObj, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	r.clientObj = client
+}
+
+func (r *PanopDiscoverySeedResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DiscoverySeedResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Tower call
+	seed, err := r.createDiscoverySeed(ctx, discoverySeedInput{
+		ZoneId:   data.ZoneId.ValueInt64(),
+		SeedType: data.SeedType.ValueString(),
+		Value:    data.Value.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create discovery seed, got error: %s", err))
+		return
+	}
+	data.setSeed(seed)
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PanopDiscoverySeedResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DiscoverySeedResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Tower call
+	seed, err := r.getDiscoverySeed(ctx, data.Id.ValueInt64())
+	if err != nil {
+		// The discovery seed was deleted outside of Terraform.
+		if errors.Is(err, errNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read discovery seed, got error: %s", err))
+		return
+	}
+	data.setSeed(seed)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is never called as every attribute requires a replacement.
+func (r *PanopDiscoverySeedResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DiscoverySeedResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PanopDiscoverySeedResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DiscoverySeedResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Tower call, the discovery seed may already be deleted outside of Terraform
+	if err := r.deleteDiscoverySeed(ctx, data.Id.ValueInt64()); err != nil && !errors.Is(err, errNotFound) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete discovery seed, got error: %s", err))
+		return
+	}
+}
+
+func (r *PanopDiscoverySeedResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected a numeric discovery seed id, got: %q", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
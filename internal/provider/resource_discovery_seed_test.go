@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccDiscoverySeedResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with the zone discovery settings testing
+			{
+				Config: getProviderConfig(os.Getenv("PANOP_ACCESS_KEY")) + testAccDiscoverySeedResourceConfig(2, "AS64496"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("panop_discovery_seed.test", "id"),
+					resource.TestCheckResourceAttr("panop_discovery_seed.test", "seed_type", "asn"),
+					resource.TestCheckResourceAttr("panop_zone.test", "auto_discovery.enabled", "true"),
+					resource.TestCheckResourceAttr("panop_zone.test", "auto_discovery.depth", "2"),
+					resource.TestCheckResourceAttr("panop_zone.test", "auto_discovery.sources.#", "2"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "panop_discovery_seed.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Zone discovery settings update in place and seed replacement testing
+			{
+				Config: getProviderConfig(os.Getenv("PANOP_ACCESS_KEY")) + testAccDiscoverySeedResourceConfig(3, "AS64497"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("panop_zone.test", plancheck.ResourceActionUpdate),
+						plancheck.ExpectResourceAction("panop_discovery_seed.test", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("panop_zone.test", "auto_discovery.depth", "3"),
+			},
+			// Invalid AS number testing
+			{
+				Config:      getProviderConfig(os.Getenv("PANOP_ACCESS_KEY")) + testAccDiscoverySeedResourceConfig(3, "64497"),
+				ExpectError: regexp.MustCompile("Invalid Discovery Seed"),
+			},
+			// Invalid discovery depth testing
+			{
+				Config:      getProviderConfig(os.Getenv("PANOP_ACCESS_KEY")) + testAccDiscoverySeedResourceConfig(6, "AS64497"),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value"),
+			},
+		},
+	})
+}
+
+func testAccDiscoverySeedResourceConfig(depth int, asn string) string {
+	return fmt.Sprintf(`
+resource "panop_zone" "test" {
+  zone_name = "nonexist.panop.io"
+
+  auto_discovery = {
+    enabled = true
+    depth   = %d
+    sources = ["certificate_transparency", "asn"]
+  }
+}
+
+resource "panop_discovery_seed" "test" {
+  zone_id   = panop_zone.test.id
+  seed_type = "asn"
+  value     = %q
+}
+`, depth, asn)
+}
@@ -0,0 +1,300 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// findingExceptionStatuses lists the statuses a finding exception gives
+// to the findings it applies to.
+var findingExceptionStatuses = []string{"accepted", "false_positive"}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PanopFindingExceptionResource{}
+var _ resource.ResourceWithImportState = &PanopFindingExceptionResource{}
+
+func NewPanopFindingExceptionResource() resource.Resource {
+	return &PanopFindingExceptionResource{}
+}
+
+// PanopFindingExceptionResource defines the resource implementation.
+type PanopFindingExceptionResource struct {
+	clientObj
+}
+
+// FindingExceptionResourceModel describes the resource data model.
+type FindingExceptionResourceModel struct {
+	Id            types.Int64  `tfsdk:"id"`
+	FindingId     types.Int64  `tfsdk:"finding_id"`
+	RuleId        types.String `tfsdk:"rule_id"`
+	AssetId       types.Int64  `tfsdk:"asset_id"`
+	Status        types.String `tfsdk:"status"`
+	Justification types.String `tfsdk:"justification"`
+	ExpiresAt     types.String `tfsdk:"expires_at"`
+	Active        types.Bool   `tfsdk:"active"`
+}
+
+// setException sets the model from a Tower finding exception. The scope
+// is only set on import, it cannot change afterwards and Tower may report
+// the rule and asset of a single finding exception.
+func (m *FindingExceptionResourceModel) setException(exception findingExceptionResponse) {
+	m.Id = types.Int64Value(exception.Id)
+	if m.FindingId.IsNull() && m.RuleId.IsNull() {
+		if exception.FindingId != 0 {
+			m.FindingId = types.Int64Value(exception.FindingId)
+		} else {
+			m.RuleId = optionalString(exception.RuleId)
+			m.AssetId = optionalInt64(exception.AssetId)
+		}
+	}
+	m.Status = types.StringValue(exception.Status)
+	m.Justification = types.StringValue(exception.Justification)
+	m.ExpiresAt = newTimestampValue(m.ExpiresAt, exception.ExpiresAt)
+	m.Active = types.BoolValue(exception.Active)
+}
+
+// newTimestampValue returns the attribute holding a timestamp returned by
+// Tower, prior is kept when it is the same instant written differently.
+func newTimestampValue(prior types.String, timestamp string) types.String {
+	if prior.IsNull() || prior.IsUnknown() || timestamp == "" {
+		return optionalString(timestamp)
+	}
+
+	priorTime, err := time.Parse(time.RFC3339, prior.ValueString())
+	if err != nil {
+		return types.StringValue(timestamp)
+	}
+	if towerTime, err := time.Parse(time.RFC3339, timestamp); err != nil || !towerTime.Equal(priorTime) {
+		return types.StringValue(timestamp)
+	}
+	return prior
+}
+
+func (r *PanopFindingExceptionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_finding_exception"
+}
+
+func (r *PanopFindingExceptionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Risk acceptance or false positive marking of a finding, or of the findings a rule raises on an asset. " +
+			"Tower reverts the findings to open when the exception is destroyed or expires",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Finding Exception Id",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"finding_id": schema.Int64Attribute{
+				MarkdownDescription: "Id of the finding the exception applies to. Conflicts with `rule_id`",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.ExactlyOneOf(path.MatchRoot("rule_id")),
+				},
+			},
+			"rule_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the rule whose findings on `asset_id` the exception applies to, including future ones. Conflicts with `finding_id`",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.AlsoRequires(path.MatchRoot("asset_id")),
+				},
+			},
+			"asset_id": schema.Int64Attribute{
+				MarkdownDescription: "Id of the asset the `rule_id` exception applies to",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("rule_id")),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status given to the findings, `accepted` for an accepted risk or `false_positive`",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(findingExceptionStatuses...),
+				},
+			},
+			"justification": schema.StringAttribute{
+				MarkdownDescription: "Why the risk is accepted or the finding is a false positive",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 2048),
+				},
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "RFC 3339 timestamp at which the exception expires and Tower reverts the findings to open, such as `2026-06-30T00:00:00Z`. The exception does not expire when not set",
+				Optional:            true,
+				Validators: []validator.String{
+					timestampValidator{},
+				},
+			},
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "Whether the exception applies, false once it expired. Moving `expires_at` forward makes it apply again",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					activeUntilExpiryChangeModifier{},
+				},
+			},
+		},
+	}
+}
+
+func (r *PanopFindingExceptionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(clientObj)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected clientObj, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	r.clientObj = client
+}
+
+func (r *PanopFindingExceptionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FindingExceptionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Tower call
+	exception, err := r.createFindingException(ctx, findingExceptionInput{
+		FindingId:     data.FindingId.ValueInt64(),
+		RuleId:        data.RuleId.ValueString(),
+		AssetId:       data.AssetId.ValueInt64(),
+		Status:        data.Status.ValueString(),
+		Justification: data.Justification.ValueString(),
+		ExpiresAt:     data.ExpiresAt.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create finding exception, got error: %s", err))
+		return
+	}
+	data.setException(exception)
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PanopFindingExceptionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data FindingExceptionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Tower call
+	exception, err := r.getFindingException(ctx, data.Id.ValueInt64())
+	if err != nil {
+		// The finding exception was deleted outside of Terraform.
+		if errors.Is(err, errNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read finding exception, got error: %s", err))
+		return
+	}
+	data.setException(exception)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PanopFindingExceptionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data FindingExceptionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Tower call
+	exception, err := r.updateFindingException(ctx, data.Id.ValueInt64(), findingExceptionUpdateInput{
+		Status:        data.Status.ValueString(),
+		Justification: data.Justification.ValueString(),
+		ExpiresAt:     data.ExpiresAt.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update finding exception, got error: %s", err))
+		return
+	}
+	data.setException(exception)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PanopFindingExceptionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FindingExceptionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Tower call, the finding exception may already be deleted outside of Terraform
+	if err := r.deleteFindingException(ctx, data.Id.ValueInt64()); err != nil && !errors.Is(err, errNotFound) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete finding exception, got error: %s", err))
+		return
+	}
+}
+
+func (r *PanopFindingExceptionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected a numeric finding exception id, got: %q", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccFindingExceptionResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create a rule exception on an asset testing
+			{
+				Config: getProviderConfig(os.Getenv("PANOP_ACCESS_KEY")) + testAccFindingExceptionResourceConfig("accepted", "2099-06-30T00:00:00Z"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("panop_finding_exception.test", "id"),
+					resource.TestCheckResourceAttr("panop_finding_exception.test", "status", "accepted"),
+					resource.TestCheckResourceAttr("panop_finding_exception.test", "active", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "panop_finding_exception.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update in place testing
+			{
+				Config: getProviderConfig(os.Getenv("PANOP_ACCESS_KEY")) + testAccFindingExceptionResourceConfig("false_positive", "2099-12-31T00:00:00Z"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("panop_finding_exception.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("panop_finding_exception.test", "status", "false_positive"),
+			},
+			// Both scopes testing
+			{
+				Config: getProviderConfig(os.Getenv("PANOP_ACCESS_KEY")) + `
+resource "panop_finding_exception" "test" {
+  finding_id    = 1234
+  rule_id       = "tls-weak-cipher"
+  asset_id      = panop_asset.test.id
+  status        = "accepted"
+  justification = "Legacy clients"
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
+
+func testAccFindingExceptionResourceConfig(status, expiresAt string) string {
+	return fmt.Sprintf(`
+resource "panop_asset" "test" {
+  asset_name = "legacy"
+  asset_type = "dns"
+  zone_id    = 337
+}
+
+resource "panop_finding_exception" "test" {
+  rule_id       = "tls-weak-cipher"
+  asset_id      = panop_asset.test.id
+  status        = %q
+  justification = "Legacy payment terminals only support these ciphers until their replacement"
+  expires_at    = %q
+}
+`, status, expiresAt)
+}
+
+func TestNewTimestampValue(t *testing.T) {
+	for _, tc := range []struct {
+		prior     types.String
+		timestamp string
+		want      types.String
+	}{
+		{types.StringNull(), "", types.StringNull()},
+		{types.StringNull(), "2026-06-30T00:00:00Z", types.StringValue("2026-06-30T00:00:00Z")},
+		{types.StringValue("2026-06-30T02:00:00+02:00"), "2026-06-30T00:00:00Z", types.StringValue("2026-06-30T02:00:00+02:00")},
+		{types.StringValue("2026-06-30T00:00:00Z"), "2026-07-31T00:00:00Z", types.StringValue("2026-07-31T00:00:00Z")},
+		{types.StringValue("2026-06-30T00:00:00Z"), "", types.StringNull()},
+	} {
+		if got := newTimestampValue(tc.prior, tc.timestamp); !got.Equal(tc.want) {
+			t.Errorf("newTimestampValue(%s, %q) = %s, expected %s", tc.prior, tc.timestamp, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,247 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PanopIPRangeResource{}
+var _ resource.ResourceWithImportState = &PanopIPRangeResource{}
+
+func NewPanopIPRangeResource() resource.Resource {
+	return &PanopIPRangeResource{}
+}
+
+// PanopIPRangeResource defines the resource implementation. An IP range is
+// stored in Tower as a cidr asset.
+type PanopIPRangeResource struct {
+	clientObj
+}
+
+// IPRangeResourceModel describes the resource data model.
+type IPRangeResourceModel struct {
+	Id        types.Int64 `tfsdk:"id"`
+	Cidr      CIDRValue   `tfsdk:"cidr"`
+	ZoneId    types.Int64 `tfsdk:"zone_id"`
+	IPVersion types.Int64 `tfsdk:"ip_version"`
+}
+
+func (r *PanopIPRangeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ip_range"
+}
+
+func (r *PanopIPRangeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "IPv4 or IPv6 range monitored by Tower, such as cloud egress ranges",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "IP Range Id",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"cidr": schema.StringAttribute{
+				MarkdownDescription: "IPv4 or IPv6 CIDR block, such as `192.0.2.0/24` or `2001:db8::/32`. A bare address is a single address range",
+				CustomType:          CIDRType{},
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"zone_id": schema.Int64Attribute{
+				MarkdownDescription: "Zone Id",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"ip_version": schema.Int64Attribute{
+				MarkdownDescription: "IP version of the range, 4 or 6",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *PanopIPRangeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(clientObj)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected clientObj, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	r.clientObj = client
+}
+
+func (r *PanopIPRangeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data IPRangeResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	prefix, err := parseCIDR(data.Cidr.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("cidr"), "Invalid CIDR Block", err.Error())
+		return
+	}
+
+	// Tower call.
+	asset, err := r.createAsset(ctx, assetInput{
+		AssetName: prefix.String(),
+		AssetType: assetTypeCIDR,
+		ZoneId:    data.ZoneId.ValueInt64(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create ip range, got error: %s", err))
+		return
+	}
+
+	data.Id = types.Int64Value(asset.AssetId)
+	data.IPVersion = types.Int64Value(ipVersion(prefix.Addr().Is4()))
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PanopIPRangeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data IPRangeResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Tower call
+	asset, found, err := r.findAsset(ctx, data.Id.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read ip range, got error: %s", err))
+		return
+	}
+
+	// The ip range was deleted outside of Terraform.
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if asset.AssetType != assetTypeCIDR {
+		resp.Diagnostics.AddError("Not An IP Range",
+			fmt.Sprintf("The asset %q (id %d) is a %s asset, not a cidr asset.", asset.AssetName, asset.AssetId, asset.AssetType))
+		return
+	}
+
+	data.Cidr = NewCIDRValue(asset.AssetName)
+	data.ZoneId = types.Int64Value(asset.ZoneId)
+	data.IPVersion = types.Int64Null()
+	if prefix, err := parseCIDR(asset.AssetName); err == nil {
+		data.IPVersion = types.Int64Value(ipVersion(prefix.Addr().Is4()))
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PanopIPRangeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data IPRangeResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Every attribute requires a replacement, so only semantically equal
+	// cidr changes such as 192.0.2.1/24 to 192.0.2.0/24 reach Update.
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PanopIPRangeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data IPRangeResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Tower call
+	if err := r.deleteAsset(ctx, data.Id.ValueInt64()); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete ip range, got error: %s", err))
+		return
+	}
+}
+
+func (r *PanopIPRangeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected a numeric ip range id, got: %q", req.ID))
+		return
+	}
+
+	// Tower call
+	asset, found, err := r.findAsset(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read ip range, got error: %s", err))
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddError("Asset Not Found", fmt.Sprintf("No asset with id %d was found.", id))
+		return
+	}
+	if asset.AssetType != assetTypeCIDR {
+		resp.Diagnostics.AddError("Not An IP Range",
+			fmt.Sprintf("The asset %q (id %d) is a %s asset, import it as a panop_asset instead.", asset.AssetName, asset.AssetId, asset.AssetType))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// ipVersion returns 4 for IPv4 and 6 for IPv6.
+func ipVersion(is4 bool) int64 {
+	if is4 {
+		return 4
+	}
+	return 6
+}
@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIPRangeResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: getProviderConfig(os.Getenv("PANOP_ACCESS_KEY")) + testAccIPRangeResourceConfig("192.0.2.0/24", 337),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("panop_ip_range.test", "cidr", "192.0.2.0/24"),
+					resource.TestCheckResourceAttr("panop_ip_range.test", "zone_id", "337"),
+					resource.TestCheckResourceAttr("panop_ip_range.test", "ip_version", "4"),
+					resource.TestCheckResourceAttrSet("panop_ip_range.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "panop_ip_range.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIPRangeResource_validation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      getProviderConfig(os.Getenv("PANOP_ACCESS_KEY")) + testAccIPRangeResourceConfig("192.0.2.0/33", 337),
+				ExpectError: regexp.MustCompile("Invalid CIDR Block"),
+			},
+			{
+				Config:      getProviderConfig(os.Getenv("PANOP_ACCESS_KEY")) + testAccAssetResourceConfig("2001:db8::1/64", "ip", 337),
+				ExpectError: regexp.MustCompile("Invalid Asset Name"),
+			},
+		},
+	})
+}
+
+func testAccIPRangeResourceConfig(cidr string, zoneId int64) string {
+	return fmt.Sprintf(`
+resource "panop_ip_range" "test" {
+  cidr = "%s"
+  zone_id = %d
+}
+`, cidr, zoneId)
+}
@@ -0,0 +1,442 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// scanCreateTimeout is how long Create waits for a scan to finish
+	// unless the timeouts block says otherwise.
+	scanCreateTimeout = 30 * time.Minute
+
+	// scanPollInterval is the delay between two scan status reads.
+	scanPollInterval = 10 * time.Second
+)
+
+// scanSummaryAttrTypes are the attribute types of the scan summary object.
+var scanSummaryAttrTypes = map[string]attr.Type{
+	"critical": types.Int64Type,
+	"high":     types.Int64Type,
+	"medium":   types.Int64Type,
+	"low":      types.Int64Type,
+	"info":     types.Int64Type,
+	"total":    types.Int64Type,
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PanopScanResource{}
+var _ resource.ResourceWithImportState = &PanopScanResource{}
+
+func NewPanopScanResource() resource.Resource {
+	return &PanopScanResource{}
+}
+
+// PanopScanResource defines the resource implementation. A scan is started
+// on create and cannot be changed afterwards, destroying the resource only
+// cancels a scan still in progress.
+type PanopScanResource struct {
+	clientObj
+}
+
+// ScanResourceModel describes the resource data model.
+type ScanResourceModel struct {
+	Id                types.Int64    `tfsdk:"id"`
+	ZoneIds           types.Set      `tfsdk:"zone_ids"`
+	AssetIds          types.Set      `tfsdk:"asset_ids"`
+	Triggers          types.Map      `tfsdk:"triggers"`
+	WaitForCompletion types.Bool     `tfsdk:"wait_for_completion"`
+	Status            types.String   `tfsdk:"status"`
+	StartedAt         types.String   `tfsdk:"started_at"`
+	FinishedAt        types.String   `tfsdk:"finished_at"`
+	Summary           types.Object   `tfsdk:"summary"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+}
+
+// setScan sets the computed attributes Tower reports of the scan.
+func (m *ScanResourceModel) setScan(scan scanResponse) {
+	m.Id = types.Int64Value(scan.Id)
+	m.Status = types.StringValue(scan.Status)
+	m.StartedAt = optionalString(scan.StartedAt)
+	m.FinishedAt = optionalString(scan.FinishedAt)
+
+	summary := scan.Summary
+	m.Summary = types.ObjectValueMust(scanSummaryAttrTypes, map[string]attr.Value{
+		"critical": types.Int64Value(summary.Critical),
+		"high":     types.Int64Value(summary.High),
+		"medium":   types.Int64Value(summary.Medium),
+		"low":      types.Int64Value(summary.Low),
+		"info":     types.Int64Value(summary.Info),
+		"total":    types.Int64Value(summary.Critical + summary.High + summary.Medium + summary.Low + summary.Info),
+	})
+}
+
+// newIdsValue returns the set attribute holding ids returned by Tower,
+// prior is kept when both are empty to avoid a diff between null and [].
+func newIdsValue(prior types.Set, ids []int64) types.Set {
+	if len(ids) == 0 && !prior.IsUnknown() && len(prior.Elements()) == 0 {
+		return prior
+	}
+
+	elements := make([]attr.Value, 0, len(ids))
+	for _, id := range ids {
+		elements = append(elements, types.Int64Value(id))
+	}
+	return types.SetValueMust(types.Int64Type, elements)
+}
+
+// idsFromValue returns the ids held by a set attribute, nil when the
+// attribute is null or unknown.
+func idsFromValue(ctx context.Context, value types.Set) ([]int64, diag.Diagnostics) {
+	if value.IsNull() || value.IsUnknown() {
+		return nil, nil
+	}
+
+	var ids []int64
+	diags := value.ElementsAs(ctx, &ids, false)
+	return ids, diags
+}
+
+// scanFinished reports whether a scan with status has ended.
+func scanFinished(status string) bool {
+	return status == scanStatusCompleted || status == scanStatusFailed || status == scanStatusCancelled
+}
+
+// waitForScan reads the scan with get every interval until it ends or ctx
+// is done.
+func waitForScan(ctx context.Context, get func(context.Context) (scanResponse, error), interval time.Duration) (scanResponse, error) {
+	for {
+		scan, err := get(ctx)
+		if err != nil {
+			return scan, err
+		}
+		if scanFinished(scan.Status) {
+			return scan, nil
+		}
+
+		tflog.Debug(ctx, "waiting for scan", map[string]any{"id": scan.Id, "status": scan.Status})
+
+		select {
+		case <-ctx.Done():
+			return scan, fmt.Errorf("scan %d still %s: %w", scan.Id, scan.Status, ctx.Err())
+		case <-time.After(interval):
+		}
+	}
+}
+
+func (r *PanopScanResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_scan"
+}
+
+func (r *PanopScanResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "On-demand Tower scan of zones and assets. The scan starts when the resource is created and runs again whenever it is replaced, such as on a `triggers` change",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Scan Id",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"zone_ids": schema.SetAttribute{
+				MarkdownDescription: "Ids of the zones to scan, every asset of the zones is scanned",
+				ElementType:         types.Int64Type,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.AtLeastOneOf(path.MatchRoot("asset_ids")),
+				},
+			},
+			"asset_ids": schema.SetAttribute{
+				MarkdownDescription: "Ids of the assets to scan",
+				ElementType:         types.Int64Type,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values that start a new scan when changed, such as the version of a deployment",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				MarkdownDescription: "Whether to wait for the scan to finish on create, within the create timeout. A scan that does not complete fails the apply. Defaults to `true`",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Scan status, one of `queued`, `running`, `completed`, `failed` or `cancelled`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"started_at": schema.StringAttribute{
+				MarkdownDescription: "RFC 3339 timestamp of the scan start",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"finished_at": schema.StringAttribute{
+				MarkdownDescription: "RFC 3339 timestamp of the scan end",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"summary": schema.SingleNestedAttribute{
+				MarkdownDescription: "Findings of the scan by severity",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"critical": schema.Int64Attribute{
+						MarkdownDescription: "Critical findings",
+						Computed:            true,
+					},
+					"high": schema.Int64Attribute{
+						MarkdownDescription: "High findings",
+						Computed:            true,
+					},
+					"medium": schema.Int64Attribute{
+						MarkdownDescription: "Medium findings",
+						Computed:            true,
+					},
+					"low": schema.Int64Attribute{
+						MarkdownDescription: "Low findings",
+						Computed:            true,
+					},
+					"info": schema.Int64Attribute{
+						MarkdownDescription: "Informational findings",
+						Computed:            true,
+					},
+					"total": schema.Int64Attribute{
+						MarkdownDescription: "All findings",
+						Computed:            true,
+					},
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *PanopScanResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(clientObj)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected clientObj, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	r.clientObj = client
+}
+
+func (r *PanopScanResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ScanResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, scanCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zoneIds, diags := idsFromValue(ctx, data.ZoneIds)
+	resp.Diagnostics.Append(diags...)
+	assetIds, diags := idsFromValue(ctx, data.AssetIds)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Tower call
+	scan, err := r.createScan(ctx, scanInput{ZoneIds: zoneIds, AssetIds: assetIds})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create scan, got error: %s", err))
+		return
+	}
+	data.setScan(scan)
+
+	tflog.Trace(ctx, "created a resource")
+
+	if data.WaitForCompletion.ValueBool() {
+		waitCtx, cancel := context.WithTimeout(ctx, createTimeout)
+		defer cancel()
+
+		scan, err = waitForScan(waitCtx, func(ctx context.Context) (scanResponse, error) {
+			return r.getScan(ctx, data.Id.ValueInt64())
+		}, scanPollInterval)
+		if err == nil {
+			data.setScan(scan)
+		}
+
+		// The scan is saved even when it did not complete, so that it is
+		// tainted and started again on the next apply.
+		switch {
+		case err != nil:
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to wait for scan %d, got error: %s", data.Id.ValueInt64(), err))
+		case scan.Status != scanStatusCompleted:
+			resp.Diagnostics.AddError("Scan Not Completed", fmt.Sprintf("The scan %d ended with status %q.", scan.Id, scan.Status))
+		}
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PanopScanResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ScanResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Tower call
+	scan, err := r.getScan(ctx, data.Id.ValueInt64())
+	if err != nil {
+		// The scan was deleted outside of Terraform.
+		if errors.Is(err, errNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read scan, got error: %s", err))
+		return
+	}
+	data.setScan(scan)
+
+	// Imported scans have neither targets nor configuration defaults yet.
+	// Tower may report the zones of the targeted assets, so the targets are
+	// not refreshed otherwise.
+	if data.ZoneIds.IsNull() && data.AssetIds.IsNull() {
+		data.ZoneIds = newIdsValue(data.ZoneIds, scan.ZoneIds)
+		data.AssetIds = newIdsValue(data.AssetIds, scan.AssetIds)
+	}
+	if data.WaitForCompletion.IsNull() {
+		data.WaitForCompletion = types.BoolValue(true)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PanopScanResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ScanResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The scan targets and triggers require a replacement, so only
+	// wait_for_completion and timeouts changes reach Update, they only
+	// matter on create.
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PanopScanResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ScanResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Tower call
+	scan, err := r.getScan(ctx, data.Id.ValueInt64())
+	// The scan was already deleted outside of Terraform.
+	if errors.Is(err, errNotFound) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read scan, got error: %s", err))
+		return
+	}
+
+	// Finished scans stay in the Tower scan history.
+	if scan.Status != scanStatusQueued && scan.Status != scanStatusRunning {
+		return
+	}
+	if err := r.cancelScan(ctx, scan.Id); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to cancel scan, got error: %s", err))
+		return
+	}
+}
+
+func (r *PanopScanResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected a numeric scan id, got: %q", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
@@ -0,0 +1,367 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// timeOfDayRegexp matches a time of day such as 22:30.
+var timeOfDayRegexp = regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PanopScanPolicyResource{}
+var _ resource.ResourceWithImportState = &PanopScanPolicyResource{}
+
+func NewPanopScanPolicyResource() resource.Resource {
+	return &PanopScanPolicyResource{}
+}
+
+// PanopScanPolicyResource defines the resource implementation.
+type PanopScanPolicyResource struct {
+	clientObj
+}
+
+// ScanPolicyResourceModel describes the resource data model.
+type ScanPolicyResourceModel struct {
+	Id              types.Int64               `tfsdk:"id"`
+	Name            types.String              `tfsdk:"name"`
+	Frequency       types.String              `tfsdk:"frequency"`
+	Modules         types.Set                 `tfsdk:"modules"`
+	PortRanges      types.List                `tfsdk:"port_ranges"`
+	Intensity       types.String              `tfsdk:"intensity"`
+	BlackoutWindows []ScanBlackoutWindowModel `tfsdk:"blackout_windows"`
+}
+
+// ScanBlackoutWindowModel describes a blackout window of the resource data
+// model.
+type ScanBlackoutWindowModel struct {
+	Days     types.Set    `tfsdk:"days"`
+	Start    types.String `tfsdk:"start"`
+	End      types.String `tfsdk:"end"`
+	Timezone types.String `tfsdk:"timezone"`
+}
+
+// setPolicy sets the model from a Tower scan policy.
+func (m *ScanPolicyResourceModel) setPolicy(policy scanPolicyResponse) {
+	m.Id = types.Int64Value(policy.Id)
+	m.Name = types.StringValue(policy.Name)
+	m.Frequency = types.StringValue(policy.Frequency)
+	m.Modules = newStringsSetValue(policy.Modules)
+	m.PortRanges = newStringsListValue(policy.PortRanges)
+	m.Intensity = types.StringValue(policy.Intensity)
+
+	// An empty blackout_windows list stays empty rather than null.
+	if m.BlackoutWindows != nil {
+		m.BlackoutWindows = []ScanBlackoutWindowModel{}
+	}
+	for _, window := range policy.BlackoutWindows {
+		m.BlackoutWindows = append(m.BlackoutWindows, ScanBlackoutWindowModel{
+			Days:     newStringsSetValue(window.Days),
+			Start:    types.StringValue(window.Start),
+			End:      types.StringValue(window.End),
+			Timezone: types.StringValue(window.Timezone),
+		})
+	}
+}
+
+// input returns the Tower scan policy input of the model.
+func (m ScanPolicyResourceModel) input(ctx context.Context) (scanPolicyInput, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	in := scanPolicyInput{
+		Name:            m.Name.ValueString(),
+		Frequency:       m.Frequency.ValueString(),
+		Intensity:       m.Intensity.ValueString(),
+		BlackoutWindows: []scanBlackoutWindow{},
+	}
+	if !m.Modules.IsNull() && !m.Modules.IsUnknown() {
+		diags.Append(m.Modules.ElementsAs(ctx, &in.Modules, false)...)
+	}
+	if !m.PortRanges.IsNull() && !m.PortRanges.IsUnknown() {
+		diags.Append(m.PortRanges.ElementsAs(ctx, &in.PortRanges, false)...)
+	}
+	for _, window := range m.BlackoutWindows {
+		blackout := scanBlackoutWindow{
+			Start:    window.Start.ValueString(),
+			End:      window.End.ValueString(),
+			Timezone: window.Timezone.ValueString(),
+		}
+		diags.Append(window.Days.ElementsAs(ctx, &blackout.Days, false)...)
+		in.BlackoutWindows = append(in.BlackoutWindows, blackout)
+	}
+	return in, diags
+}
+
+// newStringsSetValue returns the set attribute holding values.
+func newStringsSetValue(values []string) types.Set {
+	elements := make([]attr.Value, 0, len(values))
+	for _, value := range values {
+		elements = append(elements, types.StringValue(value))
+	}
+	return types.SetValueMust(types.StringType, elements)
+}
+
+// newStringsListValue returns the list attribute holding values.
+func newStringsListValue(values []string) types.List {
+	elements := make([]attr.Value, 0, len(values))
+	for _, value := range values {
+		elements = append(elements, types.StringValue(value))
+	}
+	return types.ListValueMust(types.StringType, elements)
+}
+
+func (r *PanopScanPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_scan_policy"
+}
+
+func (r *PanopScanPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Scan policy setting how often and how deeply Tower scans the zones it is attached to with their `policy_id`",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Scan Policy Id",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Scan Policy Name",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			"frequency": schema.StringAttribute{
+				MarkdownDescription: "Scan frequency, one of `hourly`, `daily`, `weekly` or `monthly`",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(scanFrequencies...),
+				},
+			},
+			"modules": schema.SetAttribute{
+				MarkdownDescription: "Scan modules enabled, among `discovery`, `ports`, `web`, `tls` and `vulnerabilities`. Every module is enabled when not set",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(scanModules...)),
+				},
+			},
+			"port_ranges": schema.ListAttribute{
+				MarkdownDescription: "Ports scanned, as single ports or ranges such as `443` or `8000-8100`. Tower scans its default ports when not set",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(portRangeValidator{}),
+				},
+			},
+			"intensity": schema.StringAttribute{
+				MarkdownDescription: "Scan intensity, one of `light`, `normal` or `aggressive`. Defaults to `normal`",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("normal"),
+				Validators: []validator.String{
+					stringvalidator.OneOf(scanIntensities...),
+				},
+			},
+			"blackout_windows": schema.ListNestedAttribute{
+				MarkdownDescription: "Weekly windows during which Tower does not scan, such as business hours",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"days": schema.SetAttribute{
+							MarkdownDescription: "Days of the window, among `mon`, `tue`, `wed`, `thu`, `fri`, `sat` and `sun`",
+							ElementType:         types.StringType,
+							Required:            true,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+								setvalidator.ValueStringsAre(stringvalidator.OneOf(weekdays...)),
+							},
+						},
+						"start": schema.StringAttribute{
+							MarkdownDescription: "Start time of the window, such as `08:00`",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(timeOfDayRegexp, "must be a time of day such as 08:00"),
+							},
+						},
+						"end": schema.StringAttribute{
+							MarkdownDescription: "End time of the window, such as `18:00`. The window ends the next day when end is before start",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(timeOfDayRegexp, "must be a time of day such as 18:00"),
+							},
+						},
+						"timezone": schema.StringAttribute{
+							MarkdownDescription: "IANA time zone of start and end, such as `Europe/Paris`. Defaults to `UTC`",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString("UTC"),
+							Validators: []validator.String{
+								timeZoneValidator{},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *PanopScanPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(clientObj)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected clientObj, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	r.clientObj = client
+}
+
+func (r *PanopScanPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ScanPolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input, diags := data.input(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Tower call
+	policy, err := r.createScanPolicy(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create scan policy, got error: %s", err))
+		return
+	}
+	data.setPolicy(policy)
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PanopScanPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ScanPolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Tower call
+	policy, err := r.getScanPolicy(ctx, data.Id.ValueInt64())
+	if err != nil {
+		// The scan policy was deleted outside of Terraform.
+		if errors.Is(err, errNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read scan policy, got error: %s", err))
+		return
+	}
+	data.setPolicy(policy)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PanopScanPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ScanPolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input, diags := data.input(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Tower call
+	policy, err := r.updateScanPolicy(ctx, data.Id.ValueInt64(), input)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update scan policy, got error: %s", err))
+		return
+	}
+	data.setPolicy(policy)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PanopScanPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ScanPolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Tower call, the scan policy may already be deleted outside of Terraform
+	if err := r.deleteScanPolicy(ctx, data.Id.ValueInt64()); err != nil && !errors.Is(err, errNotFound) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete scan policy, got error: %s", err))
+		return
+	}
+}
+
+func (r *PanopScanPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected a numeric scan policy id, got: %q", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
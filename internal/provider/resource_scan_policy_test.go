@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccScanPolicyResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and attach to a zone testing
+			{
+				Config: getProviderConfig(os.Getenv("PANOP_ACCESS_KEY")) + testAccScanPolicyResourceConfig("daily", "8000-8100"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("panop_scan_policy.test", "id"),
+					resource.TestCheckResourceAttr("panop_scan_policy.test", "frequency", "daily"),
+					resource.TestCheckResourceAttr("panop_scan_policy.test", "intensity", "normal"),
+					resource.TestCheckResourceAttr("panop_scan_policy.test", "modules.#", "2"),
+					resource.TestCheckResourceAttr("panop_scan_policy.test", "blackout_windows.0.timezone", "Europe/Paris"),
+					resource.TestCheckResourceAttrPair("panop_zone.test", "policy_id", "panop_scan_policy.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "panop_scan_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update in place testing
+			{
+				Config: getProviderConfig(os.Getenv("PANOP_ACCESS_KEY")) + testAccScanPolicyResourceConfig("weekly", "1-1024"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("panop_scan_policy.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("panop_scan_policy.test", "frequency", "weekly"),
+					resource.TestCheckResourceAttr("panop_scan_policy.test", "port_ranges.1", "1-1024"),
+				),
+			},
+			// Invalid port range testing
+			{
+				Config:      getProviderConfig(os.Getenv("PANOP_ACCESS_KEY")) + testAccScanPolicyResourceConfig("weekly", "1024-1"),
+				ExpectError: regexp.MustCompile("Invalid Port Range"),
+			},
+		},
+	})
+}
+
+func testAccScanPolicyResourceConfig(frequency, portRange string) string {
+	return fmt.Sprintf(`
+resource "panop_scan_policy" "test" {
+  name        = "business hours off"
+  frequency   = %[1]q
+  modules     = ["ports", "tls"]
+  port_ranges = ["443", %[2]q]
+
+  blackout_windows = [
+    {
+      days     = ["mon", "tue", "wed", "thu", "fri"]
+      start    = "08:00"
+      end      = "18:00"
+      timezone = "Europe/Paris"
+    },
+  ]
+}
+
+resource "panop_zone" "test" {
+  zone_name = "nonexist.panop.io"
+  policy_id = panop_scan_policy.test.id
+}
+`, frequency, portRange)
+}
+
+func TestScanPolicySetPolicyBlackoutWindows(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		prior    []ScanBlackoutWindowModel
+		windows  []scanBlackoutWindow
+		wantNil  bool
+		wantSize int
+	}{
+		{"null stays null", nil, nil, true, 0},
+		{"empty stays empty", []ScanBlackoutWindowModel{}, nil, false, 0},
+		{"windows read", nil, []scanBlackoutWindow{{Days: []string{"sat"}, Start: "00:00", End: "06:00", Timezone: "UTC"}}, false, 1},
+	} {
+		data := ScanPolicyResourceModel{BlackoutWindows: tc.prior}
+		data.setPolicy(scanPolicyResponse{scanPolicyInput: scanPolicyInput{BlackoutWindows: tc.windows}})
+
+		if (data.BlackoutWindows == nil) != tc.wantNil || len(data.BlackoutWindows) != tc.wantSize {
+			t.Errorf("%s: expected nil %t with %d windows, got %#v", tc.name, tc.wantNil, tc.wantSize, data.BlackoutWindows)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccScanResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and wait for completion testing
+			{
+				Config: getProviderConfig(os.Getenv("PANOP_ACCESS_KEY")) + testAccScanResourceConfig("v1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("panop_scan.test", "id"),
+					resource.TestCheckResourceAttr("panop_scan.test", "status", "completed"),
+					resource.TestCheckResourceAttrSet("panop_scan.test", "finished_at"),
+					resource.TestCheckResourceAttrSet("panop_scan.test", "summary.total"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "panop_scan.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"triggers", "timeouts"},
+			},
+			// New scan on triggers change testing
+			{
+				Config: getProviderConfig(os.Getenv("PANOP_ACCESS_KEY")) + testAccScanResourceConfig("v2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("panop_scan.test", plancheck.ResourceActionReplace),
+					},
+				},
+			},
+			// Missing targets testing
+			{
+				Config: getProviderConfig(os.Getenv("PANOP_ACCESS_KEY")) + `
+resource "panop_scan" "test" {
+  wait_for_completion = false
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
+
+func testAccScanResourceConfig(version string) string {
+	return fmt.Sprintf(`
+resource "panop_scan" "test" {
+  zone_ids = [337]
+  triggers = {
+    version = %q
+  }
+
+  timeouts {
+    create = "45m"
+  }
+}
+`, version)
+}
+
+func TestWaitForScan(t *testing.T) {
+	statuses := []string{scanStatusQueued, scanStatusRunning, scanStatusCompleted}
+	reads := 0
+	get := func(ctx context.Context) (scanResponse, error) {
+		scan := scanResponse{Id: 42, Status: statuses[reads]}
+		reads++
+		return scan, nil
+	}
+
+	scan, err := waitForScan(context.Background(), get, time.Millisecond)
+	if err != nil {
+		t.Fatalf("waitForScan returned unexpected error: %s", err)
+	}
+	if scan.Status != scanStatusCompleted || reads != 3 {
+		t.Errorf("expected a completed scan after 3 reads, got %q after %d reads", scan.Status, reads)
+	}
+}
+
+func TestWaitForScanTimeout(t *testing.T) {
+	get := func(ctx context.Context) (scanResponse, error) {
+		return scanResponse{Id: 42, Status: scanStatusRunning}, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	scan, err := waitForScan(ctx, get, time.Millisecond)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected a deadline exceeded error, got %v", err)
+	}
+	if scan.Status != scanStatusRunning {
+		t.Errorf("expected the last read status running, got %q", scan.Status)
+	}
+}
@@ -0,0 +1,417 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// urlPathRegexp matches an absolute URL path such as /admin.
+var urlPathRegexp = regexp.MustCompile(`^/\S*$`)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PanopScopeExclusionResource{}
+var _ resource.ResourceWithImportState = &PanopScopeExclusionResource{}
+var _ resource.ResourceWithModifyPlan = &PanopScopeExclusionResource{}
+var _ resource.ResourceWithValidateConfig = &PanopScopeExclusionResource{}
+
+func NewPanopScopeExclusionResource() resource.Resource {
+	return &PanopScopeExclusionResource{}
+}
+
+// PanopScopeExclusionResource defines the resource implementation.
+type PanopScopeExclusionResource struct {
+	clientObj
+}
+
+// ScopeExclusionResourceModel describes the resource data model.
+type ScopeExclusionResourceModel struct {
+	Id     types.Int64  `tfsdk:"id"`
+	ZoneId types.Int64  `tfsdk:"zone_id"`
+	Hosts  types.Set    `tfsdk:"hosts"`
+	Cidrs  types.Set    `tfsdk:"cidrs"`
+	Ports  types.Set    `tfsdk:"ports"`
+	Paths  types.Set    `tfsdk:"paths"`
+	Reason types.String `tfsdk:"reason"`
+}
+
+// setExclusion sets the model from a Tower scope exclusion.
+func (m *ScopeExclusionResourceModel) setExclusion(exclusion scopeExclusionResponse) {
+	m.Id = types.Int64Value(exclusion.Id)
+	m.ZoneId = types.Int64Value(exclusion.ZoneId)
+	m.Hosts = newOptionalStringsSetValue(m.Hosts, exclusion.Hosts)
+	m.Cidrs = newOptionalStringsSetValue(m.Cidrs, exclusion.Cidrs)
+	m.Ports = newOptionalStringsSetValue(m.Ports, exclusion.Ports)
+	m.Paths = newOptionalStringsSetValue(m.Paths, exclusion.Paths)
+	m.Reason = optionalString(exclusion.Reason)
+}
+
+// input returns the Tower scope exclusion input of the model.
+func (m ScopeExclusionResourceModel) input(ctx context.Context) (scopeExclusionInput, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	in := scopeExclusionInput{
+		ZoneId: m.ZoneId.ValueInt64(),
+		Reason: m.Reason.ValueString(),
+	}
+	for _, rule := range []struct {
+		value  types.Set
+		target *[]string
+	}{
+		{m.Hosts, &in.Hosts},
+		{m.Cidrs, &in.Cidrs},
+		{m.Ports, &in.Ports},
+		{m.Paths, &in.Paths},
+	} {
+		*rule.target = []string{}
+		if !rule.value.IsNull() && !rule.value.IsUnknown() {
+			diags.Append(rule.value.ElementsAs(ctx, rule.target, false)...)
+		}
+	}
+	return in, diags
+}
+
+// newOptionalStringsSetValue returns the set attribute holding values
+// returned by Tower, prior is kept when both are empty to avoid a diff
+// between null and [].
+func newOptionalStringsSetValue(prior types.Set, values []string) types.Set {
+	if len(values) == 0 && !prior.IsUnknown() && len(prior.Elements()) == 0 {
+		return prior
+	}
+	return newStringsSetValue(values)
+}
+
+func (r *PanopScopeExclusionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_scope_exclusion"
+}
+
+func (r *PanopScopeExclusionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Hosts, address blocks, ports or URL paths of a zone that Tower must never scan, " +
+			"such as third-party SaaS hosts, fragile legacy systems or paths a pentest contract excludes",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Scope Exclusion Id",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"zone_id": schema.Int64Attribute{
+				MarkdownDescription: "Id of the zone the exclusion applies to",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"hosts": schema.SetAttribute{
+				MarkdownDescription: "Host names or wildcards such as `*.saas.example.com` within the zone that are never scanned",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(hostPatternValidator{}),
+					setvalidator.AtLeastOneOf(path.MatchRoot("cidrs"), path.MatchRoot("ports"), path.MatchRoot("paths")),
+				},
+			},
+			"cidrs": schema.SetAttribute{
+				MarkdownDescription: "IPv4 or IPv6 CIDR blocks or addresses that are never scanned",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(cidrValidator{}),
+				},
+			},
+			"ports": schema.SetAttribute{
+				MarkdownDescription: "Ports or port ranges such as `8000-8100` that are never scanned",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(portRangeValidator{}),
+				},
+			},
+			"paths": schema.SetAttribute{
+				MarkdownDescription: "URL paths such as `/admin` that web scans never request, along with the paths below them",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(urlPathRegexp, "must be a URL path starting with /"),
+					),
+				},
+			},
+			"reason": schema.StringAttribute{
+				MarkdownDescription: "Why the scope is excluded",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 2048),
+				},
+			},
+		},
+	}
+}
+
+func (r *PanopScopeExclusionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ScopeExclusionResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.Hosts.IsNull() || data.Hosts.IsUnknown() {
+		return
+	}
+	var hosts []types.String
+	resp.Diagnostics.Append(data.Hosts.ElementsAs(ctx, &hosts, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The zone name is only known to Tower, ModifyPlan checks the fully
+	// qualified hosts against it.
+	for _, host := range hosts {
+		if host.IsUnknown() || validateHostPattern(host.ValueString()) != nil {
+			continue
+		}
+		if _, err := hostPatternZoneHostname(host.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("hosts"),
+				"Host Outside Of Zone",
+				fmt.Sprintf("%q does not belong to a zone: %s.", host.ValueString(), err),
+			)
+		}
+	}
+}
+
+func (r *PanopScopeExclusionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data ScopeExclusionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The zone is looked up when the exclusion is created or its hosts or
+	// zone change.
+	if !req.State.Raw.IsNull() {
+		var state ScopeExclusionResourceModel
+
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+		if resp.Diagnostics.HasError() || (state.ZoneId.Equal(data.ZoneId) && state.Hosts.Equal(data.Hosts)) {
+			return
+		}
+	}
+
+	// The zone name is looked up in Tower to check the excluded hosts
+	// belong to the zone.
+	if r.clientHttp == nil || data.ZoneId.IsUnknown() || data.Hosts.IsNull() || data.Hosts.IsUnknown() {
+		return
+	}
+	var hosts []types.String
+	resp.Diagnostics.Append(data.Hosts.ElementsAs(ctx, &hosts, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var hostnames []string
+	for _, host := range hosts {
+		if host.IsUnknown() {
+			continue
+		}
+		if hostname, err := hostPatternZoneHostname(host.ValueString()); err == nil && hostname != "" {
+			hostnames = append(hostnames, host.ValueString())
+		}
+	}
+	if len(hostnames) == 0 {
+		return
+	}
+
+	zones, err := r.listZones(ctx)
+	if err != nil {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("zone_id"),
+			"Zone Check Skipped",
+			fmt.Sprintf("Unable to list zones to check the excluded hosts belong to the zone %d, got error: %s",
+				data.ZoneId.ValueInt64(), err),
+		)
+		return
+	}
+
+	for _, zone := range zones {
+		if zone.Id == data.ZoneId.ValueInt64() {
+			for _, host := range hostnames {
+				if !assetInZone(strings.TrimPrefix(host, "*."), zone.ZoneName) {
+					resp.Diagnostics.AddAttributeError(
+						path.Root("hosts"),
+						"Host Outside Of Zone",
+						fmt.Sprintf("The host %q does not belong to the zone %q (id %d).",
+							host, zone.ZoneName, zone.Id),
+					)
+				}
+			}
+			return
+		}
+	}
+}
+
+func (r *PanopScopeExclusionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(clientObj)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected clientObj, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	r.clientObj = client
+}
+
+func (r *PanopScopeExclusionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ScopeExclusionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in, diags := data.input(ctx)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Tower call
+	exclusion, err := r.createScopeExclusion(ctx, in)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create scope exclusion, got error: %s", err))
+		return
+	}
+	data.setExclusion(exclusion)
+
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PanopScopeExclusionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ScopeExclusionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Tower call
+	exclusion, err := r.getScopeExclusion(ctx, data.Id.ValueInt64())
+	if err != nil {
+		// The scope exclusion was deleted outside of Terraform.
+		if errors.Is(err, errNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read scope exclusion, got error: %s", err))
+		return
+	}
+	data.setExclusion(exclusion)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PanopScopeExclusionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ScopeExclusionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in, diags := data.input(ctx)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Tower call
+	exclusion, err := r.updateScopeExclusion(ctx, data.Id.ValueInt64(), in)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update scope exclusion, got error: %s", err))
+		return
+	}
+	data.setExclusion(exclusion)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PanopScopeExclusionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ScopeExclusionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Tower call, the scope exclusion may already be deleted outside of Terraform
+	if err := r.deleteScopeExclusion(ctx, data.Id.ValueInt64()); err != nil && !errors.Is(err, errNotFound) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete scope exclusion, got error: %s", err))
+		return
+	}
+}
+
+func (r *PanopScopeExclusionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected a numeric scope exclusion id, got: %q", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
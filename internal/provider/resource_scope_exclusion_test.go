@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccScopeExclusionResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: getProviderConfig(os.Getenv("PANOP_ACCESS_KEY")) + testAccScopeExclusionResourceConfig("*.saas.nonexist.panop.io", "/admin"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("panop_scope_exclusion.test", "id"),
+					resource.TestCheckResourceAttr("panop_scope_exclusion.test", "hosts.#", "2"),
+					resource.TestCheckResourceAttr("panop_scope_exclusion.test", "ports.#", "1"),
+					resource.TestCheckNoResourceAttr("panop_scope_exclusion.test", "cidrs"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "panop_scope_exclusion.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update in place testing
+			{
+				Config: getProviderConfig(os.Getenv("PANOP_ACCESS_KEY")) + testAccScopeExclusionResourceConfig("*.saas.nonexist.panop.io", "/legacy"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("panop_scope_exclusion.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckTypeSetElemAttr("panop_scope_exclusion.test", "paths.*", "/legacy"),
+			},
+			// Host outside of the zone testing
+			{
+				Config:      getProviderConfig(os.Getenv("PANOP_ACCESS_KEY")) + testAccScopeExclusionResourceConfig("*.example.com", "/legacy"),
+				ExpectError: regexp.MustCompile("Host Outside Of Zone"),
+			},
+			// Invalid path testing
+			{
+				Config:      getProviderConfig(os.Getenv("PANOP_ACCESS_KEY")) + testAccScopeExclusionResourceConfig("*.saas.nonexist.panop.io", "admin"),
+				ExpectError: regexp.MustCompile("must be a URL path"),
+			},
+		},
+	})
+}
+
+func testAccScopeExclusionResourceConfig(host, urlPath string) string {
+	return fmt.Sprintf(`
+resource "panop_zone" "test" {
+  zone_name = "nonexist.panop.io"
+}
+
+resource "panop_scope_exclusion" "test" {
+  zone_id = panop_zone.test.id
+  hosts   = ["legacy.nonexist.panop.io", %q]
+  ports   = ["8000-8100"]
+  paths   = [%q]
+  reason  = "Third-party hosts and fragile legacy systems"
+}
+`, host, urlPath)
+}
@@ -8,15 +8,25 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"io"
 	"net/http"
 	"net/url"
-	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -24,6 +34,9 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &PanopZoneResource{}
 var _ resource.ResourceWithImportState = &PanopZoneResource{}
+var _ resource.ResourceWithIdentity = &PanopZoneResource{}
+var _ resource.ResourceWithUpgradeState = &PanopZoneResource{}
+var _ resource.ResourceWithModifyPlan = &PanopZoneResource{}
 
 func NewPanopZoneResource() resource.Resource {
 	return &PanopZoneResource{}
@@ -31,17 +44,116 @@ func NewPanopZoneResource() resource.Resource {
 
 // PanopZoneResource defines the resource implementation.
 type PanopZoneResource struct {
-	clientHttp *http.Client
-	host       string
-	accessKey  string
+	clientObj
 }
 
 // ZoneResourceModel describes the resource data model.
 type ZoneResourceModel struct {
+	ZoneName  DomainNameValue `tfsdk:"zone_name"`
+	Id        types.Int64     `tfsdk:"id"`
+	ZoneType  types.String    `tfsdk:"zone_type"`
+	Token     types.String    `tfsdk:"token"`
+	Validated types.Bool      `tfsdk:"validated"`
+	Tags      types.Map       `tfsdk:"tags"`
+	TagsAll   types.Map       `tfsdk:"tags_all"`
+
+	Criticality  types.String `tfsdk:"criticality"`
+	OwnerEmail   types.String `tfsdk:"owner_email"`
+	BusinessUnit types.String `tfsdk:"business_unit"`
+	Description  types.String `tfsdk:"description"`
+
+	PolicyId      types.Int64             `tfsdk:"policy_id"`
+	AutoDiscovery *ZoneAutoDiscoveryModel `tfsdk:"auto_discovery"`
+}
+
+// ZoneAutoDiscoveryModel describes the discovery settings of the zone data
+// models.
+type ZoneAutoDiscoveryModel struct {
+	Enabled types.Bool  `tfsdk:"enabled"`
+	Depth   types.Int64 `tfsdk:"depth"`
+	Sources types.Set   `tfsdk:"sources"`
+}
+
+// newZoneAutoDiscoveryModel maps Tower discovery settings to the model, nil
+// for the Tower default settings. Prior null sources are kept when Tower
+// returns none.
+func newZoneAutoDiscoveryModel(prior *ZoneAutoDiscoveryModel, discovery *zoneAutoDiscovery) *ZoneAutoDiscoveryModel {
+	if discovery == nil {
+		return nil
+	}
+
+	sources := types.SetNull(types.StringType)
+	if prior != nil {
+		sources = prior.Sources
+	}
+	return &ZoneAutoDiscoveryModel{
+		Enabled: types.BoolValue(discovery.Enabled),
+		Depth:   types.Int64Value(discovery.Depth),
+		Sources: newOptionalStringsSetValue(sources, discovery.Sources),
+	}
+}
+
+// input returns the Tower discovery settings of the model, nil for the
+// Tower default settings.
+func (m *ZoneAutoDiscoveryModel) input(ctx context.Context) (*zoneAutoDiscovery, diag.Diagnostics) {
+	if m == nil {
+		return nil, nil
+	}
+
+	var diags diag.Diagnostics
+	discovery := &zoneAutoDiscovery{
+		Enabled: m.Enabled.ValueBool(),
+		Depth:   m.Depth.ValueInt64(),
+		Sources: []string{},
+	}
+	if !m.Sources.IsNull() && !m.Sources.IsUnknown() {
+		diags.Append(m.Sources.ElementsAs(ctx, &discovery.Sources, false)...)
+	}
+	return discovery, diags
+}
+
+// equal reports whether m and o hold the same discovery settings.
+func (m *ZoneAutoDiscoveryModel) equal(o *ZoneAutoDiscoveryModel) bool {
+	if m == nil || o == nil {
+		return m == o
+	}
+	return m.Enabled.Equal(o.Enabled) && m.Depth.Equal(o.Depth) && m.Sources.Equal(o.Sources)
+}
+
+// ZoneIdentityModel describes the resource identity data model.
+type ZoneIdentityModel struct {
+	TenantId types.Int64  `tfsdk:"tenant_id"`
 	ZoneName types.String `tfsdk:"zone_name"`
 	Id       types.Int64  `tfsdk:"id"`
-	ZoneType types.String `tfsdk:"zone_type"`
-	Token    types.String `tfsdk:"token"`
+}
+
+func newZoneIdentityModel(zone zoneResponse) ZoneIdentityModel {
+	return ZoneIdentityModel{
+		TenantId: types.Int64Value(zone.TenantId),
+		ZoneName: types.StringValue(zone.ZoneName),
+		Id:       types.Int64Value(zone.Id),
+	}
+}
+
+// newZoneResourceModel maps a Tower zone to the panop_zone resource model.
+func newZoneResourceModel(zone zoneResponse) ZoneResourceModel {
+	return ZoneResourceModel{
+		ZoneName:  NewDomainNameValue(zone.ZoneName),
+		Id:        types.Int64Value(zone.Id),
+		ZoneType:  types.StringValue(zone.ZoneType),
+		Token:     types.StringValue(zone.Token),
+		Validated: types.BoolValue(zone.Validated),
+		Tags:      newTagsValue(types.MapNull(types.StringType), zone.Tags),
+		TagsAll:   newTagsValue(types.MapNull(types.StringType), zone.Tags),
+
+		Criticality:  optionalString(zone.Criticality),
+		OwnerEmail:   optionalString(zone.OwnerEmail),
+		BusinessUnit: optionalString(zone.BusinessUnit),
+		Description:  optionalString(zone.Description),
+
+		PolicyId:      optionalInt64(zone.PolicyId),
+		AutoDiscovery: newZoneAutoDiscoveryModel(nil, zone.AutoDiscovery),
+	}
 }
 
 func (r *PanopZoneResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -53,29 +165,196 @@ func (r *PanopZoneResource) Schema(ctx context.Context, req resource.SchemaReque
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: "ZoneResponse description",
 
+		// Bump Version once per release changing the schema, not once per
+		// change, and map the prior version in UpgradeState, see
+		// resource_zone_upgrade.go.
+		Version: 6,
+
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Zone Id",
 				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
 			},
 			"zone_name": schema.StringAttribute{
 				MarkdownDescription: "Zone Name",
+				CustomType:          DomainNameType{},
 				Required:            true,
+				Validators: []validator.String{
+					domainNameValidator{},
+				},
+				PlanModifiers: []planmodifier.String{
+					domainNameRequiresReplace(),
+				},
 			},
 			"zone_type": schema.StringAttribute{
 				MarkdownDescription: "ZoneResponse Type",
 				Optional:            true,
 				Computed:            true,
 				Default:             stringdefault.StaticString("dns"),
+				Validators: []validator.String{
+					stringvalidator.OneOf(zoneTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"token": schema.StringAttribute{
 				Computed: true,
 				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"validated": schema.BoolAttribute{
+				MarkdownDescription: "Whether Tower validated the ownership of the zone",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tags": schema.MapAttribute{
+				MarkdownDescription: "Tags of the zone, such as environment or owner",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators:          tagsValidators,
+			},
+			"tags_all": schema.MapAttribute{
+				MarkdownDescription: "Tags of the zone merged with the provider `default_tags`",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"criticality": schema.StringAttribute{
+				MarkdownDescription: "Business criticality of the zone, one of `low`, `medium`, `high` or `critical`, used to prioritize its findings",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(criticalities...),
+				},
+			},
+			"owner_email": schema.StringAttribute{
+				MarkdownDescription: "Email address of the zone owner",
+				Optional:            true,
+				Validators: []validator.String{
+					emailValidator{},
+				},
+			},
+			"business_unit": schema.StringAttribute{
+				MarkdownDescription: "Business unit the zone belongs to",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the zone",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 1024),
+				},
+			},
+			"policy_id": schema.Int64Attribute{
+				MarkdownDescription: "Id of the scan policy of the zone, the Tower default policy applies when not set",
+				Optional:            true,
+			},
+			"auto_discovery": schema.SingleNestedAttribute{
+				MarkdownDescription: "How Tower discovers the subdomains and assets of the zone, from the zone and its `panop_discovery_seed`. The Tower default settings apply when not set",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						MarkdownDescription: "Whether Tower discovers assets on its own",
+						Required:            true,
+					},
+					"depth": schema.Int64Attribute{
+						MarkdownDescription: "How many times Tower expands the assets it discovers into new ones, from 1 to 5. Defaults to `2`",
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(2),
+						Validators: []validator.Int64{
+							int64validator.Between(1, 5),
+						},
+					},
+					"sources": schema.SetAttribute{
+						MarkdownDescription: "Discovery sources, among `certificate_transparency`, `passive_dns`, `dns_bruteforce`, `reverse_whois`, `asn` and `search_engines`. Every source is used when not set",
+						ElementType:         types.StringType,
+						Optional:            true,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.ValueStringsAre(stringvalidator.OneOf(discoverySources...)),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *PanopZoneResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"tenant_id": identityschema.Int64Attribute{
+				Description:       "Tenant Id",
+				OptionalForImport: true,
+			},
+			"zone_name": identityschema.StringAttribute{
+				Description:       "Zone Name",
+				OptionalForImport: true,
+			},
+			"id": identityschema.Int64Attribute{
+				Description:       "Zone Id",
+				OptionalForImport: true,
 			},
 		},
 	}
 }
 
+func (r *PanopZoneResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan ZoneResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tagsAll, diags := planTagsAll(ctx, plan.Tags, r.defaultTags)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags_all"), tagsAll)...)
+
+	// Nothing to explain on create.
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var state ZoneResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var changes []attributeChange
+	if normalizeDomainName(state.ZoneName.ValueString()) != normalizeDomainName(plan.ZoneName.ValueString()) {
+		changes = append(changes, attributeChange{"zone_name", state.ZoneName, plan.ZoneName})
+	}
+	if !state.ZoneType.Equal(plan.ZoneType) {
+		changes = append(changes, attributeChange{"zone_type", state.ZoneType, plan.ZoneType})
+	}
+
+	addReplacementWarning(&resp.Diagnostics, "Zone Replacement",
+		fmt.Sprintf("Tower cannot rename a zone or change its type, so the zone %q (id %d) will be deleted and created again. "+
+			"The new zone gets a new id and token and its ownership must be validated again.",
+			state.ZoneName.ValueString(), state.Id.ValueInt64()),
+		changes)
+}
+
 func (r *PanopZoneResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -92,9 +371,7 @@ func (r *PanopZoneResource) Configure(ctx context.Context, req resource.Configur
 
 		return
 	}
-	r.clientHttp = client.clientHttp
-	r.host = client.host
-	r.accessKey = client.accessKey
+	r.clientObj = client
 }
 
 func (r *PanopZoneResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -114,13 +391,42 @@ func (r *PanopZoneResource) Create(ctx context.Context, req resource.CreateReque
 		Path:   "/api/zones",
 	}
 	type ZoneInput struct {
-		ZoneName string `json:"zone_name"`
-		ZoneType string `json:"zone_type"`
-		TenantId int64  `gorm:"index" json:"tenant_id"`
+		ZoneName string            `json:"zone_name"`
+		ZoneType string            `json:"zone_type"`
+		TenantId int64             `gorm:"index" json:"tenant_id"`
+		Tags     map[string]string `json:"tags,omitempty"`
+
+		Criticality  string `json:"criticality,omitempty"`
+		OwnerEmail   string `json:"owner_email,omitempty"`
+		BusinessUnit string `json:"business_unit,omitempty"`
+		Description  string `json:"description,omitempty"`
+
+		PolicyId      int64              `json:"policy_id,omitempty"`
+		AutoDiscovery *zoneAutoDiscovery `json:"auto_discovery,omitempty"`
+	}
+	// Tower holds the tags merged with the provider default tags.
+	tags, diags := tagsFromValue(ctx, data.TagsAll)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	autoDiscovery, diags := data.AutoDiscovery.input(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 	zoneInput := ZoneInput{
 		ZoneName: data.ZoneName.ValueString(),
 		ZoneType: data.ZoneType.ValueString(),
+		Tags:     tags,
+
+		Criticality:  data.Criticality.ValueString(),
+		OwnerEmail:   data.OwnerEmail.ValueString(),
+		BusinessUnit: data.BusinessUnit.ValueString(),
+		Description:  data.Description.ValueString(),
+
+		PolicyId:      data.PolicyId.ValueInt64(),
+		AutoDiscovery: autoDiscovery,
 	}
 	body, _ := json.Marshal(zoneInput)
 
@@ -144,6 +450,7 @@ func (r *PanopZoneResource) Create(ctx context.Context, req resource.CreateReque
 		ZoneType  string `json:"zone_type"`
 		Validated bool   `json:"validated"`
 		Token     string `json:"token"`
+		TenantId  int64  `json:"tenant_id"`
 	}
 
 	respBody, err := io.ReadAll(httpResp.Body)
@@ -162,11 +469,19 @@ func (r *PanopZoneResource) Create(ctx context.Context, req resource.CreateReque
 
 	data.Token = types.StringValue(zone.Token)
 	data.Id = types.Int64Value(int64(zone.ZoneId))
+	data.Validated = types.BoolValue(zone.Validated)
 
 	tflog.Trace(ctx, "created a resource")
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	identity := newZoneIdentityModel(zoneResponse{
+		Id:       data.Id.ValueInt64(),
+		ZoneName: data.ZoneName.ValueString(),
+		TenantId: zone.TenantId,
+	})
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, identity)...)
 }
 
 func (r *PanopZoneResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -200,15 +515,6 @@ func (r *PanopZoneResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	type ZoneResponse struct {
-		Id        int64  `json:"id"`
-		ZoneName  string `json:"zone_name"`
-		ZoneType  string `json:"zone_type"`
-		Validated bool   `json:"validated"`
-		Token     string `json:"token"`
-		TenantId  uint   `json:"tenant_id"`
-	}
-
 	respBody, err := io.ReadAll(httpResp.Body)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create zone, got error: %s", err))
@@ -221,17 +527,34 @@ func (r *PanopZoneResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	zones := []ZoneResponse{}
+	zones := []zoneResponse{}
 	_ = json.Unmarshal(respBody, &zones)
+	found := false
 	for _, zone := range zones {
 		if zone.Id == data.Id.ValueInt64() {
-			data.ZoneName = types.StringValue(zone.ZoneName)
+			found = true
+			data.ZoneName = NewDomainNameValue(zone.ZoneName)
 			data.Token = types.StringValue(zone.Token)
 			data.ZoneType = types.StringValue(zone.ZoneType)
+			data.Validated = types.BoolValue(zone.Validated)
+			data.Tags, data.TagsAll = readTags(data.Tags, zone.Tags, r.defaultTags)
+			data.Criticality = optionalString(zone.Criticality)
+			data.OwnerEmail = optionalString(zone.OwnerEmail)
+			data.BusinessUnit = optionalString(zone.BusinessUnit)
+			data.Description = optionalString(zone.Description)
+			data.PolicyId = optionalInt64(zone.PolicyId)
+			data.AutoDiscovery = newZoneAutoDiscoveryModel(data.AutoDiscovery, zone.AutoDiscovery)
+			resp.Diagnostics.Append(resp.Identity.Set(ctx, newZoneIdentityModel(zone))...)
 			break
 		}
 	}
 
+	// The zone was deleted outside of Terraform.
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -246,13 +569,54 @@ func (r *PanopZoneResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
-	// If applicable, this is a great opportunity to initialize any necessary
-	// provider client data and make a call using it.
-	// httpResp, err := r.client.Do(httpReq)
-	// if err != nil {
-	//     resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update example, got error: %s", err))
-	//     return
-	// }
+	var state ZoneResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Tags, business metadata, the scan policy and the discovery settings
+	// are the attributes Tower updates in place, other changes are cosmetic
+	// such as the case of zone_name. Tower holds the tags merged with the
+	// provider default tags.
+	if !data.TagsAll.Equal(state.TagsAll) ||
+		!data.Criticality.Equal(state.Criticality) ||
+		!data.OwnerEmail.Equal(state.OwnerEmail) ||
+		!data.BusinessUnit.Equal(state.BusinessUnit) ||
+		!data.Description.Equal(state.Description) ||
+		!data.PolicyId.Equal(state.PolicyId) ||
+		!data.AutoDiscovery.equal(state.AutoDiscovery) {
+		tags, diags := tagsFromValue(ctx, data.TagsAll)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if tags == nil {
+			tags = map[string]string{}
+		}
+		autoDiscovery, diags := data.AutoDiscovery.input(ctx)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Tower call
+		input := zoneUpdateInput{
+			Tags:          tags,
+			Criticality:   data.Criticality.ValueString(),
+			OwnerEmail:    data.OwnerEmail.ValueString(),
+			BusinessUnit:  data.BusinessUnit.ValueString(),
+			Description:   data.Description.ValueString(),
+			PolicyId:      data.PolicyId.ValueInt64(),
+			AutoDiscovery: autoDiscovery,
+		}
+		if err := r.updateZone(ctx, data.Id.ValueInt64(), input); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update zone, got error: %s", err))
+			return
+		}
+	}
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -297,6 +661,24 @@ func (r *PanopZoneResource) Delete(ctx context.Context, req resource.DeleteReque
 }
 
 func (r *PanopZoneResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	id, _ := strconv.ParseInt(req.ID, 10, 64)
+	var id int64
+	var diags diag.Diagnostics
+
+	// Import blocks may use the resource identity instead of an import id.
+	if req.ID == "" && req.Identity != nil {
+		var identity ZoneIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		id, diags = resolveZoneIdentity(ctx, r.clientObj, identity)
+	} else {
+		id, diags = resolveZoneImportID(ctx, r.clientObj, req.ID)
+	}
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
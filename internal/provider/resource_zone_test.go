@@ -6,9 +6,15 @@ package provider
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestAccZoneResource(t *testing.T) {
@@ -34,10 +40,130 @@ func TestAccZoneResource(t *testing.T) {
 				// the upstream service, this can be removed.
 				ImportStateVerifyIgnore: []string{"token"},
 			},
+			// ImportState by zone name testing
+			{
+				ResourceName:            "panop_zone.test",
+				ImportState:             true,
+				ImportStateId:           "nonexist.panop.io",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"token"},
+			},
+			// Update of the zone name case is planned in place
+			{
+				Config: getProviderConfig(os.Getenv("PANOP_ACCESS_KEY")) + testAccExampleZoneResourceConfig("NonExist.panop.io"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("panop_zone.test", plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+			// Rename requires a replacement
+			{
+				Config: getProviderConfig(os.Getenv("PANOP_ACCESS_KEY")) + testAccExampleZoneResourceConfig("nonexist2.panop.io"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("panop_zone.test", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+			},
 		},
 	})
 }
 
+func TestAccZoneResource_identity(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			// Create and identity testing
+			{
+				Config: getProviderConfig(os.Getenv("PANOP_ACCESS_KEY")) + testAccExampleZoneResourceConfig("nonexist.panop.io"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentityValue("panop_zone.test", tfjsonpath.New("zone_name"), knownvalue.StringExact("nonexist.panop.io")),
+					statecheck.ExpectIdentityValueMatchesState("panop_zone.test", tfjsonpath.New("id")),
+				},
+			},
+			// ImportState by identity testing
+			{
+				ResourceName:    "panop_zone.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
+func TestAccZoneResource_validation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      getProviderConfig(os.Getenv("PANOP_ACCESS_KEY")) + testAccExampleZoneResourceConfig("not a domain"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid Domain Name"),
+			},
+			{
+				Config: getProviderConfig(os.Getenv("PANOP_ACCESS_KEY")) + `
+resource "panop_zone" "test" {
+  zone_name = "nonexist.panop.io"
+  zone_type = "dnss"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+		},
+	})
+}
+
+func TestAccZoneResource_tags(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with tags testing
+			{
+				Config: getProviderConfig(os.Getenv("PANOP_ACCESS_KEY")) + testAccZoneResourceTagsConfig("nonexist.panop.io", "prod"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("panop_zone.test", "tags.%", "2"),
+					resource.TestCheckResourceAttr("panop_zone.test", "tags.env", "prod"),
+				),
+			},
+			// Update tags in place testing
+			{
+				Config: getProviderConfig(os.Getenv("PANOP_ACCESS_KEY")) + testAccZoneResourceTagsConfig("nonexist.panop.io", "staging"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("panop_zone.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("panop_zone.test", "tags.env", "staging"),
+			},
+			// Remove tags testing
+			{
+				Config: getProviderConfig(os.Getenv("PANOP_ACCESS_KEY")) + testAccExampleZoneResourceConfig("nonexist.panop.io"),
+				Check:  resource.TestCheckNoResourceAttr("panop_zone.test", "tags.%"),
+			},
+		},
+	})
+}
+
+func testAccZoneResourceTagsConfig(zoneName, env string) string {
+	return fmt.Sprintf(`
+resource "panop_zone" "test" {
+  zone_name = %[1]q
+  tags = {
+    env  = %[2]q
+    team = "security"
+  }
+}
+`, zoneName, env)
+}
+
 func testAccExampleZoneResourceConfig(configurableAttribute string) string {
 	return fmt.Sprintf(`
 resource "panop_zone" "test" {
@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// zoneResourceModelV5 describes the version 5 resource data model.
+type zoneResourceModelV5 struct {
+	ZoneName  types.String `tfsdk:"zone_name"`
+	Id        types.Int64  `tfsdk:"id"`
+	ZoneType  types.String `tfsdk:"zone_type"`
+	Token     types.String `tfsdk:"token"`
+	Validated types.Bool   `tfsdk:"validated"`
+	Tags      types.Map    `tfsdk:"tags"`
+	TagsAll   types.Map    `tfsdk:"tags_all"`
+
+	Criticality  types.String `tfsdk:"criticality"`
+	OwnerEmail   types.String `tfsdk:"owner_email"`
+	BusinessUnit types.String `tfsdk:"business_unit"`
+	Description  types.String `tfsdk:"description"`
+
+	PolicyId types.Int64 `tfsdk:"policy_id"`
+}
+
+// zoneSchemaV5 is the schema of panop_zone version 5, it has no
+// auto_discovery.
+//
+// Version 0 to 4 states are read with this schema too, version 0 has no
+// validated, version 1 no tags, version 2 no tags_all, version 3 no
+// business metadata and version 4 no policy_id. Attributes missing from a
+// state are read as null.
+var zoneSchemaV5 = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Computed: true,
+		},
+		"zone_name": schema.StringAttribute{
+			Required: true,
+		},
+		"zone_type": schema.StringAttribute{
+			Optional: true,
+			Computed: true,
+		},
+		"token": schema.StringAttribute{
+			Computed: true,
+		},
+		"validated": schema.BoolAttribute{
+			Computed: true,
+		},
+		"tags": schema.MapAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+		},
+		"tags_all": schema.MapAttribute{
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+		"criticality": schema.StringAttribute{
+			Optional: true,
+		},
+		"owner_email": schema.StringAttribute{
+			Optional: true,
+		},
+		"business_unit": schema.StringAttribute{
+			Optional: true,
+		},
+		"description": schema.StringAttribute{
+			Optional: true,
+		},
+		"policy_id": schema.Int64Attribute{
+			Optional: true,
+		},
+	},
+}
+
+func (r *PanopZoneResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	upgrader := resource.StateUpgrader{
+		PriorSchema:   &zoneSchemaV5,
+		StateUpgrader: upgradeZoneState,
+	}
+
+	return map[int64]resource.StateUpgrader{
+		0: upgrader,
+		1: upgrader,
+		2: upgrader,
+		3: upgrader,
+		4: upgrader,
+		5: upgrader,
+	}
+}
+
+// upgradeZoneState upgrades a version 0 to 5 state to the current version.
+// A missing validated is left null until the next refresh, a missing
+// tags_all equals tags as Tower held no default tags, a missing policy_id
+// is left null, the Tower default policy, and auto_discovery is left null,
+// the Tower default discovery settings.
+func upgradeZoneState(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var prior zoneResourceModelV5
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data := ZoneResourceModel{
+		ZoneName:  DomainNameValue{StringValue: prior.ZoneName},
+		Id:        prior.Id,
+		ZoneType:  prior.ZoneType,
+		Token:     prior.Token,
+		Validated: prior.Validated,
+		Tags:      prior.Tags,
+		TagsAll:   prior.TagsAll,
+
+		Criticality:  prior.Criticality,
+		OwnerEmail:   prior.OwnerEmail,
+		BusinessUnit: prior.BusinessUnit,
+		Description:  prior.Description,
+
+		PolicyId: prior.PolicyId,
+	}
+	if data.TagsAll.IsNull() {
+		data.TagsAll = prior.Tags
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
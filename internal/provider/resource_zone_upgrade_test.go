@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testUpgradeResourceState runs the UpgradeResourceState RPC of the provider
+// on a raw JSON state and returns the upgraded attributes.
+func testUpgradeResourceState(t *testing.T, typeName string, version int64, rawState string) map[string]tftypes.Value {
+	t.Helper()
+
+	ctx := context.Background()
+	server, err := testAccProtoV6ProviderFactories["panop"]()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := server.UpgradeResourceState(ctx, &tfprotov6.UpgradeResourceStateRequest{
+		TypeName: typeName,
+		Version:  version,
+		RawState: &tfprotov6.RawState{JSON: []byte(rawState)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range resp.Diagnostics {
+		t.Errorf("unexpected diagnostic: %s: %s", d.Summary, d.Detail)
+	}
+	if t.Failed() {
+		t.FailNow()
+	}
+
+	value, err := resp.UpgradedState.Unmarshal(schemaResp.ResourceSchemas[typeName].ValueType())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	attributes := map[string]tftypes.Value{}
+	if err := value.As(&attributes); err != nil {
+		t.Fatal(err)
+	}
+	return attributes
+}
+
+func TestZoneResourceUpgradeStateV0(t *testing.T) {
+	attributes := testUpgradeResourceState(t, "panop_zone", 0,
+		`{"id": 416, "zone_name": "example.com", "zone_type": "dns", "token": "abc"}`)
+
+	var zoneName string
+	if err := attributes["zone_name"].As(&zoneName); err != nil || zoneName != "example.com" {
+		t.Errorf("expected zone_name example.com, got %q (%v)", zoneName, err)
+	}
+	if !attributes["id"].Equal(tftypes.NewValue(tftypes.Number, 416)) {
+		t.Errorf("expected id 416, got %s", attributes["id"])
+	}
+	if !attributes["validated"].IsNull() {
+		t.Errorf("expected validated to be null, got %s", attributes["validated"])
+	}
+	if !attributes["tags"].IsNull() {
+		t.Errorf("expected tags to be null, got %s", attributes["tags"])
+	}
+}
+
+func TestZoneResourceUpgradeStateV1(t *testing.T) {
+	attributes := testUpgradeResourceState(t, "panop_zone", 1,
+		`{"id": 416, "zone_name": "example.com", "zone_type": "dns", "token": "abc", "validated": true}`)
+
+	if !attributes["validated"].Equal(tftypes.NewValue(tftypes.Bool, true)) {
+		t.Errorf("expected validated true, got %s", attributes["validated"])
+	}
+	if !attributes["tags"].IsNull() {
+		t.Errorf("expected tags to be null, got %s", attributes["tags"])
+	}
+}
+
+func TestZoneResourceUpgradeStateV2(t *testing.T) {
+	attributes := testUpgradeResourceState(t, "panop_zone", 2,
+		`{"id": 416, "zone_name": "example.com", "zone_type": "dns", "token": "abc", "validated": true, "tags": {"env": "prod"}}`)
+
+	if !attributes["tags_all"].Equal(attributes["tags"]) || attributes["tags_all"].IsNull() {
+		t.Errorf("expected tags_all to equal tags, got %s and %s", attributes["tags_all"], attributes["tags"])
+	}
+}
+
+func TestZoneResourceUpgradeStateV3(t *testing.T) {
+	attributes := testUpgradeResourceState(t, "panop_zone", 3,
+		`{"id": 416, "zone_name": "example.com", "zone_type": "dns", "token": "abc", "validated": true, "tags": {"env": "prod"}, "tags_all": {"env": "prod", "team": "security"}}`)
+
+	var tagsAll map[string]tftypes.Value
+	if err := attributes["tags_all"].As(&tagsAll); err != nil || len(tagsAll) != 2 {
+		t.Errorf("expected tags_all to be kept, got %s (%v)", attributes["tags_all"], err)
+	}
+	if !attributes["criticality"].IsNull() {
+		t.Errorf("expected criticality to be null, got %s", attributes["criticality"])
+	}
+}
+
+func TestZoneResourceUpgradeStateV4(t *testing.T) {
+	attributes := testUpgradeResourceState(t, "panop_zone", 4,
+		`{"id": 416, "zone_name": "example.com", "zone_type": "dns", "token": "abc", "validated": true, "criticality": "high", "owner_email": "secops@example.com"}`)
+
+	var criticality string
+	if err := attributes["criticality"].As(&criticality); err != nil || criticality != "high" {
+		t.Errorf("expected criticality high, got %q (%v)", criticality, err)
+	}
+	if !attributes["policy_id"].IsNull() {
+		t.Errorf("expected policy_id to be null, got %s", attributes["policy_id"])
+	}
+}
+
+func TestZoneResourceUpgradeStateV5(t *testing.T) {
+	attributes := testUpgradeResourceState(t, "panop_zone", 5,
+		`{"id": 416, "zone_name": "example.com", "zone_type": "dns", "token": "abc", "validated": true, "policy_id": 7}`)
+
+	if !attributes["policy_id"].Equal(tftypes.NewValue(tftypes.Number, 7)) {
+		t.Errorf("expected policy_id 7, got %s", attributes["policy_id"])
+	}
+	if !attributes["auto_discovery"].IsNull() {
+		t.Errorf("expected auto_discovery to be null, got %s", attributes["auto_discovery"])
+	}
+}
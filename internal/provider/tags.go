@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// tagsValidators validates the keys and values of a tags map: keys of 1 to
+// 128 characters and values of at most 256 characters.
+var tagsValidators = []validator.Map{
+	mapvalidator.KeysAre(stringvalidator.LengthBetween(1, 128)),
+	mapvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256)),
+}
+
+// tagsFromValue returns the tags held by a tags map attribute, nil when the
+// attribute is null or unknown.
+func tagsFromValue(ctx context.Context, value types.Map) (map[string]string, diag.Diagnostics) {
+	if value.IsNull() || value.IsUnknown() {
+		return nil, nil
+	}
+
+	tags := map[string]string{}
+	diags := value.ElementsAs(ctx, &tags, false)
+	return tags, diags
+}
+
+// newTagsValue returns the tags map attribute holding tags returned by
+// Tower. Tower does not tell an empty map from no tags, so prior is kept
+// when both are empty to avoid a diff between null and {}.
+func newTagsValue(prior types.Map, tags map[string]string) types.Map {
+	if len(tags) == 0 && !prior.IsUnknown() && len(prior.Elements()) == 0 {
+		return prior
+	}
+
+	elements := make(map[string]attr.Value, len(tags))
+	for key, value := range tags {
+		elements[key] = types.StringValue(value)
+	}
+	return types.MapValueMust(types.StringType, elements)
+}
+
+// hasTags reports whether tags holds every key of filter with the same
+// value.
+func hasTags(tags, filter map[string]string) bool {
+	for key, value := range filter {
+		if got, ok := tags[key]; !ok || got != value {
+			return false
+		}
+	}
+	return true
+}
+
+// mergeTags returns the default tags overridden by the resource tags.
+func mergeTags(defaultTags, tags map[string]string) map[string]string {
+	merged := make(map[string]string, len(defaultTags)+len(tags))
+	for key, value := range defaultTags {
+		merged[key] = value
+	}
+	for key, value := range tags {
+		merged[key] = value
+	}
+	return merged
+}
+
+// planTagsAll returns the planned tags_all attribute of a resource: the
+// provider default tags merged with the planned tags, unknown while the
+// tags are and null when there is no tag at all.
+func planTagsAll(ctx context.Context, tags types.Map, defaultTags map[string]string) (types.Map, diag.Diagnostics) {
+	if tags.IsUnknown() {
+		return types.MapUnknown(types.StringType), nil
+	}
+
+	resourceTags, diags := tagsFromValue(ctx, tags)
+	if diags.HasError() {
+		return types.MapUnknown(types.StringType), diags
+	}
+	return newTagsValue(types.MapNull(types.StringType), mergeTags(defaultTags, resourceTags)), diags
+}
+
+// readTags returns the tags and tags_all attributes of a resource from the
+// tags Tower holds. Tags equal to a provider default tag are left out of
+// tags, unless prior, the tags of the prior state, holds them.
+func readTags(prior types.Map, towerTags, defaultTags map[string]string) (tags, tagsAll types.Map) {
+	priorElements := prior.Elements()
+
+	resourceTags := make(map[string]string, len(towerTags))
+	for key, value := range towerTags {
+		if _, ok := priorElements[key]; !ok {
+			if defaultValue, ok := defaultTags[key]; ok && defaultValue == value {
+				continue
+			}
+		}
+		resourceTags[key] = value
+	}
+
+	return newTagsValue(prior, resourceTags), newTagsValue(types.MapNull(types.StringType), towerTags)
+}
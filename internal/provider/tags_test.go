@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNewTagsValue(t *testing.T) {
+	empty := types.MapValueMust(types.StringType, map[string]attr.Value{})
+	null := types.MapNull(types.StringType)
+	env := types.MapValueMust(types.StringType, map[string]attr.Value{"env": types.StringValue("prod")})
+
+	for _, tc := range []struct {
+		name  string
+		prior types.Map
+		tags  map[string]string
+		want  types.Map
+	}{
+		{"null stays null", null, nil, null},
+		{"empty stays empty", empty, map[string]string{}, empty},
+		{"removed tags", env, nil, empty},
+		{"tags", null, map[string]string{"env": "prod"}, env},
+	} {
+		if got := newTagsValue(tc.prior, tc.tags); !got.Equal(tc.want) {
+			t.Errorf("%s: newTagsValue(%s, %v) = %s, want %s", tc.name, tc.prior, tc.tags, got, tc.want)
+		}
+	}
+}
+
+func TestHasTags(t *testing.T) {
+	tags := map[string]string{"env": "prod", "team": "web"}
+	for _, tc := range []struct {
+		filter map[string]string
+		want   bool
+	}{
+		{nil, true},
+		{map[string]string{"env": "prod"}, true},
+		{map[string]string{"env": "prod", "team": "web"}, true},
+		{map[string]string{"env": "dev"}, false},
+		{map[string]string{"owner": "web"}, false},
+	} {
+		if got := hasTags(tags, tc.filter); got != tc.want {
+			t.Errorf("hasTags(%v, %v) = %t, want %t", tags, tc.filter, got, tc.want)
+		}
+	}
+}
+
+func TestPlanTagsAll(t *testing.T) {
+	ctx := context.Background()
+	defaults := map[string]string{"team": "security", "env": "prod"}
+
+	tags := types.MapValueMust(types.StringType, map[string]attr.Value{"env": types.StringValue("dev")})
+	got, diags := planTagsAll(ctx, tags, defaults)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	want := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"env":  types.StringValue("dev"),
+		"team": types.StringValue("security"),
+	})
+	if !got.Equal(want) {
+		t.Errorf("planTagsAll = %s, want %s", got, want)
+	}
+
+	if got, _ := planTagsAll(ctx, types.MapNull(types.StringType), nil); !got.IsNull() {
+		t.Errorf("planTagsAll without tags = %s, want null", got)
+	}
+	if got, _ := planTagsAll(ctx, types.MapUnknown(types.StringType), defaults); !got.IsUnknown() {
+		t.Errorf("planTagsAll with unknown tags = %s, want unknown", got)
+	}
+}
+
+func TestReadTags(t *testing.T) {
+	defaults := map[string]string{"team": "security", "env": "prod"}
+	towerTags := map[string]string{"team": "security", "env": "prod", "app": "shop"}
+
+	// Tags equal to a default tag are left out unless configured.
+	prior := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"env": types.StringValue("prod"),
+		"app": types.StringValue("shop"),
+	})
+	tags, tagsAll := readTags(prior, towerTags, defaults)
+	if !tags.Equal(prior) {
+		t.Errorf("readTags tags = %s, want %s", tags, prior)
+	}
+	if len(tagsAll.Elements()) != 3 {
+		t.Errorf("readTags tags_all = %s, want the 3 Tower tags", tagsAll)
+	}
+
+	// A default tag overridden in Tower shows as a resource tag.
+	tags, _ = readTags(types.MapNull(types.StringType), map[string]string{"team": "web"}, defaults)
+	want := types.MapValueMust(types.StringType, map[string]attr.Value{"team": types.StringValue("web")})
+	if !tags.Equal(want) {
+		t.Errorf("readTags tags = %s, want %s", tags, want)
+	}
+}
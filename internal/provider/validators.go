@@ -0,0 +1,428 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"net/netip"
+	"strconv"
+	"strings"
+	"time"
+	_ "time/tzdata"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// criticalities lists the business criticality levels of zones and assets.
+var criticalities = []string{"low", "medium", "high", "critical"}
+
+// zoneTypes lists the zone types supported by Tower.
+var zoneTypes = []string{"dns"}
+
+// Scan policy settings supported by Tower.
+var (
+	scanFrequencies = []string{"hourly", "daily", "weekly", "monthly"}
+	scanModules     = []string{"discovery", "ports", "web", "tls", "vulnerabilities"}
+	scanIntensities = []string{"light", "normal", "aggressive"}
+	weekdays        = []string{"mon", "tue", "wed", "thu", "fri", "sat", "sun"}
+)
+
+// Discovery settings supported by Tower.
+var (
+	discoverySources   = []string{"certificate_transparency", "passive_dns", "dns_bruteforce", "reverse_whois", "asn", "search_engines"}
+	discoverySeedTypes = []string{discoverySeedOrganization, discoverySeedASN, discoverySeedKeyword}
+)
+
+// Asset types supported by Tower.
+const (
+	assetTypeDNS      = "dns"
+	assetTypeIP       = "ip"
+	assetTypeCIDR     = "cidr"
+	assetTypeWildcard = "wildcard"
+	assetTypeURL      = "url"
+)
+
+// assetTypes lists the asset types supported by Tower.
+var assetTypes = []string{assetTypeDNS, assetTypeIP, assetTypeCIDR, assetTypeWildcard, assetTypeURL}
+
+// validateDomainName checks the syntax of a domain name: labels of 1 to 63
+// letters, digits, hyphens or underscores, not starting or ending with a
+// hyphen, 253 characters at most. A single trailing dot is accepted and
+// letters may be Unicode, so both IDN forms are valid.
+func validateDomainName(name string) error {
+	name = strings.TrimSuffix(name, ".")
+	if name == "" {
+		return fmt.Errorf("the name is empty")
+	}
+	if len(name) > 253 {
+		return fmt.Errorf("the name is longer than 253 characters")
+	}
+
+	for _, label := range strings.Split(name, ".") {
+		if label == "" {
+			return fmt.Errorf("the name contains an empty label")
+		}
+		if len(label) > 63 {
+			return fmt.Errorf("the label %q is longer than 63 characters", label)
+		}
+		if strings.HasPrefix(label, "-") || strings.HasSuffix(label, "-") {
+			return fmt.Errorf("the label %q starts or ends with a hyphen", label)
+		}
+		for _, c := range label {
+			if !unicode.IsLetter(c) && !unicode.IsDigit(c) && c != '-' && c != '_' {
+				return fmt.Errorf("the label %q contains the invalid character %q", label, c)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateAssetName checks the syntax of an asset name according to the
+// asset type: a domain name for dns assets, an IPv4 or IPv6 address for ip
+// assets, a CIDR block or a single address for cidr assets, as accepted by
+// panop_ip_range, a domain name under a leading * label for wildcard
+// assets and an http or https URL for url assets.
+func validateAssetName(assetType, name string) error {
+	switch assetType {
+	case assetTypeIP:
+		if _, err := netip.ParseAddr(name); err != nil {
+			return fmt.Errorf("an ip asset name must be an IPv4 or IPv6 address: %w", err)
+		}
+	case assetTypeCIDR:
+		if _, err := parseCIDR(name); err != nil {
+			return fmt.Errorf("a cidr asset name must be an IPv4 or IPv6 CIDR block: %w", err)
+		}
+	case assetTypeWildcard:
+		parent, ok := strings.CutPrefix(name, "*.")
+		if !ok {
+			return fmt.Errorf("a wildcard asset name must start with *.")
+		}
+		if err := validateDomainName(parent); err != nil {
+			return fmt.Errorf("a wildcard asset name must be *. followed by a domain name: %w", err)
+		}
+	case assetTypeURL:
+		u, err := parseAssetURL(name)
+		if err != nil {
+			return fmt.Errorf("a url asset name must be an http or https URL: %w", err)
+		}
+		if _, err := netip.ParseAddr(u.Hostname()); err != nil {
+			if err := validateDomainName(u.Hostname()); err != nil {
+				return fmt.Errorf("the URL host must be a domain name or an IP address: %w", err)
+			}
+		}
+	default:
+		if err := validateDomainName(name); err != nil {
+			return fmt.Errorf("a %s asset name must be a domain name: %w", assetType, err)
+		}
+	}
+	return nil
+}
+
+// assetInZone reports whether assetName designates a name within zoneName.
+// Single label names are relative to the zone, names holding a dot must be
+// the zone itself or a subdomain of it.
+func assetInZone(assetName, zoneName string) bool {
+	assetName = normalizeDomainName(assetName)
+	zoneName = normalizeDomainName(zoneName)
+
+	if !strings.Contains(assetName, ".") {
+		return true
+	}
+	return assetName == zoneName || strings.HasSuffix(assetName, "."+zoneName)
+}
+
+// assetZoneHostname returns the host name of the asset to check against the
+// name of its zone, empty when the asset belongs to the zone whatever its
+// name: addresses, CIDR blocks, URLs on an address and relative dns names.
+// It fails for wildcard and url assets on a single label host, as only dns
+// asset names can be relative to the zone.
+func assetZoneHostname(assetType, name string) (string, error) {
+	hostname := assetHostname(assetType, name)
+	if _, err := netip.ParseAddr(hostname); hostname == "" || err == nil {
+		return "", nil
+	}
+	if strings.Contains(strings.TrimSuffix(hostname, "."), ".") {
+		return hostname, nil
+	}
+	if assetType != assetTypeDNS {
+		return "", fmt.Errorf("only dns asset names can be relative to the zone, the %s asset host must be fully qualified", assetType)
+	}
+	return "", nil
+}
+
+// hostPatternZoneHostname is assetZoneHostname for a scope exclusion host
+// pattern, wildcards being checked as wildcard assets.
+func hostPatternZoneHostname(pattern string) (string, error) {
+	if strings.HasPrefix(pattern, "*.") {
+		return assetZoneHostname(assetTypeWildcard, pattern)
+	}
+	return assetZoneHostname(assetTypeDNS, pattern)
+}
+
+var _ validator.String = domainNameValidator{}
+
+// domainNameValidator validates that a string attribute is a syntactically
+// valid domain name.
+type domainNameValidator struct{}
+
+func (v domainNameValidator) Description(ctx context.Context) string {
+	return "value must be a valid domain name"
+}
+
+func (v domainNameValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v domainNameValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := validateDomainName(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Domain Name",
+			fmt.Sprintf("%q is not a valid domain name: %s.", req.ConfigValue.ValueString(), err),
+		)
+	}
+}
+
+var _ validator.String = emailValidator{}
+
+// emailValidator validates that a string attribute is a bare email
+// address, without display name.
+type emailValidator struct{}
+
+func (v emailValidator) Description(ctx context.Context) string {
+	return "value must be a valid email address"
+}
+
+func (v emailValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v emailValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if address, err := mail.ParseAddress(value); err != nil || address.Address != value {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Email Address",
+			fmt.Sprintf("%q is not a valid email address.", value),
+		)
+	}
+}
+
+var _ validator.String = timestampValidator{}
+
+// timestampValidator validates that a string attribute is an RFC 3339
+// timestamp.
+type timestampValidator struct{}
+
+func (v timestampValidator) Description(ctx context.Context) string {
+	return "value must be an RFC 3339 timestamp"
+}
+
+func (v timestampValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v timestampValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if _, err := time.Parse(time.RFC3339, value); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Timestamp",
+			fmt.Sprintf("%q is not an RFC 3339 timestamp such as 2026-01-31T18:00:00Z.", value),
+		)
+	}
+}
+
+// parsePortRange parses a port range such as 8000-8100, or a single port
+// such as 443.
+func parsePortRange(s string) (first, last int, err error) {
+	firstPort, lastPort, isRange := strings.Cut(s, "-")
+	if !isRange {
+		lastPort = firstPort
+	}
+
+	if first, err = strconv.Atoi(firstPort); err != nil || first < 1 || first > 65535 {
+		return 0, 0, fmt.Errorf("%q is not a port number between 1 and 65535", firstPort)
+	}
+	if last, err = strconv.Atoi(lastPort); err != nil || last < 1 || last > 65535 {
+		return 0, 0, fmt.Errorf("%q is not a port number between 1 and 65535", lastPort)
+	}
+	if first > last {
+		return 0, 0, fmt.Errorf("the range starts after it ends")
+	}
+	return first, last, nil
+}
+
+var _ validator.String = portRangeValidator{}
+
+// portRangeValidator validates that a string attribute is a port range
+// such as 8000-8100 or a single port.
+type portRangeValidator struct{}
+
+func (v portRangeValidator) Description(ctx context.Context) string {
+	return "value must be a port or a port range such as 8000-8100"
+}
+
+func (v portRangeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v portRangeValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if _, _, err := parsePortRange(value); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Port Range",
+			fmt.Sprintf("%q is not a valid port range: %s.", value, err),
+		)
+	}
+}
+
+var _ validator.String = timeZoneValidator{}
+
+// timeZoneValidator validates that a string attribute is an IANA time zone
+// name such as Europe/Paris.
+type timeZoneValidator struct{}
+
+func (v timeZoneValidator) Description(ctx context.Context) string {
+	return "value must be an IANA time zone name"
+}
+
+func (v timeZoneValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v timeZoneValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if _, err := time.LoadLocation(value); err != nil || value == "" || value == "Local" {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Time Zone",
+			fmt.Sprintf("%q is not an IANA time zone name such as UTC or Europe/Paris.", value),
+		)
+	}
+}
+
+// validateHostPattern validates a host pattern, a domain name or a wildcard
+// such as *.saas.example.com.
+func validateHostPattern(pattern string) error {
+	if strings.HasPrefix(pattern, "*.") {
+		return validateAssetName(assetTypeWildcard, pattern)
+	}
+	return validateDomainName(pattern)
+}
+
+var _ validator.String = hostPatternValidator{}
+
+// hostPatternValidator validates that a string attribute is a host pattern.
+type hostPatternValidator struct{}
+
+func (v hostPatternValidator) Description(ctx context.Context) string {
+	return "value must be a domain name or a wildcard such as *.example.com"
+}
+
+func (v hostPatternValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v hostPatternValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if err := validateHostPattern(value); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Host Pattern",
+			fmt.Sprintf("%q is not a valid host pattern: %s.", value, err),
+		)
+	}
+}
+
+var _ validator.String = cidrValidator{}
+
+// cidrValidator validates that a string attribute is an IPv4 or IPv6 CIDR
+// block or a bare address, for the collections CIDRType cannot be used in.
+type cidrValidator struct{}
+
+func (v cidrValidator) Description(ctx context.Context) string {
+	return "value must be an IPv4 or IPv6 CIDR block"
+}
+
+func (v cidrValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v cidrValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if _, err := parseCIDR(value); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid CIDR Block",
+			fmt.Sprintf("%q is not a valid CIDR block: %s.", value, err),
+		)
+	}
+}
+
+// validateDiscoverySeed checks the value of a discovery seed according to
+// its type: an AS number such as AS64496 for asn seeds, a name of 256
+// characters at most for organization seeds and a keyword of 128
+// characters at most for keyword seeds.
+func validateDiscoverySeed(seedType, value string) error {
+	if strings.TrimSpace(value) != value {
+		return fmt.Errorf("the value starts or ends with a space")
+	}
+	if value == "" {
+		return fmt.Errorf("the value is empty")
+	}
+
+	switch seedType {
+	case discoverySeedASN:
+		number, ok := strings.CutPrefix(value, "AS")
+		if !ok {
+			return fmt.Errorf("an asn seed must be AS followed by the AS number, such as AS64496")
+		}
+		if n, err := strconv.ParseUint(number, 10, 32); err != nil || n == 0 || strings.HasPrefix(number, "0") {
+			return fmt.Errorf("%q is not an AS number between 1 and 4294967295", number)
+		}
+	case discoverySeedOrganization:
+		if len(value) > 256 {
+			return fmt.Errorf("an organization seed is longer than 256 characters")
+		}
+	case discoverySeedKeyword:
+		if len(value) > 128 {
+			return fmt.Errorf("a keyword seed is longer than 128 characters")
+		}
+	}
+	return nil
+}
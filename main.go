@@ -7,6 +7,7 @@ import (
 	"context"
 	"flag"
 	"log"
+	"os"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/panop-io/terraform-provider-panop/internal/provider"
@@ -32,6 +33,11 @@ var (
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "export" {
+		export(os.Args[2:])
+		return
+	}
+
 	var debug bool
 
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
@@ -51,3 +57,29 @@ func main() {
 		log.Fatal(err.Error())
 	}
 }
+
+// export writes the Terraform configuration of an existing Tower tenant,
+// see provider.Export.
+func export(args []string) {
+	var opts provider.ExportOptions
+
+	flags := flag.NewFlagSet("export", flag.ExitOnError)
+	flags.StringVar(&opts.Host, "host", os.Getenv("PANOP_HOST"), "Tower host, defaults to PANOP_HOST")
+	flags.StringVar(&opts.AccessKey, "access-key", os.Getenv("PANOP_ACCESS_KEY"), "Tower access key, defaults to PANOP_ACCESS_KEY")
+	flags.BoolVar(&opts.SkipTLSVerify, "skip-tls-verify", false, "skip TLS verify")
+	flags.StringVar(&opts.OutputDir, "out", ".", "directory the .tf files are written to")
+	flags.BoolVar(&opts.IncludeDiscovered, "include-discovered", false, "also export the assets Tower discovered on its own and not adopted yet")
+	_ = flags.Parse(args)
+
+	if opts.Host == "" {
+		log.Fatal("export: -host or PANOP_HOST is required")
+	}
+
+	files, err := provider.Export(context.Background(), opts)
+	for _, file := range files {
+		log.Printf("wrote %s", file)
+	}
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+}